@@ -0,0 +1,836 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package MessageTransmitter
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// MessageTransmitterMetaData contains all meta data concerning the MessageTransmitter contract.
+var MessageTransmitterMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"receiveMessage\",\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"message\",\"type\":\"bytes\"},{\"name\":\"attestation\",\"type\":\"bytes\"}],\"outputs\":[{\"name\":\"success\",\"type\":\"bool\"}]},{\"type\":\"function\",\"name\":\"sendMessage\",\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"destinationDomain\",\"type\":\"uint32\"},{\"name\":\"recipient\",\"type\":\"bytes32\"},{\"name\":\"messageBody\",\"type\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint64\"}]},{\"type\":\"function\",\"name\":\"sendMessageWithCaller\",\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"destinationDomain\",\"type\":\"uint32\"},{\"name\":\"recipient\",\"type\":\"bytes32\"},{\"name\":\"destinationCaller\",\"type\":\"bytes32\"},{\"name\":\"messageBody\",\"type\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint64\"}]},{\"type\":\"function\",\"name\":\"replaceMessage\",\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"originalMessage\",\"type\":\"bytes\"},{\"name\":\"originalAttestation\",\"type\":\"bytes\"},{\"name\":\"newMessageBody\",\"type\":\"bytes\"},{\"name\":\"newDestinationCaller\",\"type\":\"bytes32\"}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"usedNonces\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"\",\"type\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"localDomain\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint32\"}]},{\"type\":\"function\",\"name\":\"version\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint32\"}]},{\"type\":\"function\",\"name\":\"nextAvailableNonce\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint64\"}]},{\"type\":\"function\",\"name\":\"maxMessageBodySize\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"paused\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}]},{\"type\":\"function\",\"name\":\"signatureThreshold\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"getNumEnabledAttesters\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"isEnabledAttester\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"attester\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}]},{\"type\":\"event\",\"name\":\"MessageSent\",\"anonymous\":false,\"inputs\":[{\"name\":\"message\",\"type\":\"bytes\",\"indexed\":false}]},{\"type\":\"event\",\"name\":\"MessageReceived\",\"anonymous\":false,\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"indexed\":true},{\"name\":\"sourceDomain\",\"type\":\"uint32\",\"indexed\":false},{\"name\":\"nonce\",\"type\":\"uint64\",\"indexed\":true},{\"name\":\"sender\",\"type\":\"bytes32\",\"indexed\":false},{\"name\":\"messageBody\",\"type\":\"bytes\",\"indexed\":false}]}]",
+}
+
+// MessageTransmitterABI is the input ABI used to generate the binding from.
+// Deprecated: Use MessageTransmitterMetaData.ABI instead.
+var MessageTransmitterABI = MessageTransmitterMetaData.ABI
+
+// MessageTransmitter is an auto generated Go binding around an Ethereum contract.
+type MessageTransmitter struct {
+	MessageTransmitterCaller     // Read-only binding to the contract
+	MessageTransmitterTransactor // Write-only binding to the contract
+	MessageTransmitterFilterer   // Log filterer for contract events
+}
+
+// MessageTransmitterCaller is an auto generated read-only Go binding around an Ethereum contract.
+type MessageTransmitterCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MessageTransmitterTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type MessageTransmitterTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MessageTransmitterFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type MessageTransmitterFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MessageTransmitterSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type MessageTransmitterSession struct {
+	Contract     *MessageTransmitter // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts       // Call options to use throughout this session
+	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
+}
+
+// MessageTransmitterCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type MessageTransmitterCallerSession struct {
+	Contract *MessageTransmitterCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts             // Call options to use throughout this session
+}
+
+// MessageTransmitterTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type MessageTransmitterTransactorSession struct {
+	Contract     *MessageTransmitterTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts             // Transaction auth options to use throughout this session
+}
+
+// MessageTransmitterRaw is an auto generated low-level Go binding around an Ethereum contract.
+type MessageTransmitterRaw struct {
+	Contract *MessageTransmitter // Generic contract binding to access the raw methods on
+}
+
+// MessageTransmitterCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type MessageTransmitterCallerRaw struct {
+	Contract *MessageTransmitterCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// MessageTransmitterTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type MessageTransmitterTransactorRaw struct {
+	Contract *MessageTransmitterTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewMessageTransmitter creates a new instance of MessageTransmitter, bound to a specific deployed contract.
+func NewMessageTransmitter(address common.Address, backend bind.ContractBackend) (*MessageTransmitter, error) {
+	contract, err := bindMessageTransmitter(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &MessageTransmitter{MessageTransmitterCaller: MessageTransmitterCaller{contract: contract}, MessageTransmitterTransactor: MessageTransmitterTransactor{contract: contract}, MessageTransmitterFilterer: MessageTransmitterFilterer{contract: contract}}, nil
+}
+
+// NewMessageTransmitterCaller creates a new read-only instance of MessageTransmitter, bound to a specific deployed contract.
+func NewMessageTransmitterCaller(address common.Address, caller bind.ContractCaller) (*MessageTransmitterCaller, error) {
+	contract, err := bindMessageTransmitter(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MessageTransmitterCaller{contract: contract}, nil
+}
+
+// NewMessageTransmitterTransactor creates a new write-only instance of MessageTransmitter, bound to a specific deployed contract.
+func NewMessageTransmitterTransactor(address common.Address, transactor bind.ContractTransactor) (*MessageTransmitterTransactor, error) {
+	contract, err := bindMessageTransmitter(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MessageTransmitterTransactor{contract: contract}, nil
+}
+
+// NewMessageTransmitterFilterer creates a new log filterer instance of MessageTransmitter, bound to a specific deployed contract.
+func NewMessageTransmitterFilterer(address common.Address, filterer bind.ContractFilterer) (*MessageTransmitterFilterer, error) {
+	contract, err := bindMessageTransmitter(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &MessageTransmitterFilterer{contract: contract}, nil
+}
+
+// bindMessageTransmitter binds a generic wrapper to an already deployed contract.
+func bindMessageTransmitter(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := MessageTransmitterMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MessageTransmitter *MessageTransmitterRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MessageTransmitter.Contract.MessageTransmitterCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MessageTransmitter *MessageTransmitterRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MessageTransmitter.Contract.MessageTransmitterTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MessageTransmitter *MessageTransmitterRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MessageTransmitter.Contract.MessageTransmitterTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MessageTransmitter *MessageTransmitterCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MessageTransmitter.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MessageTransmitter *MessageTransmitterTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MessageTransmitter.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MessageTransmitter *MessageTransmitterTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MessageTransmitter.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetNumEnabledAttesters is a free data retrieval call binding the contract method 0x51079a53.
+//
+// Solidity: function getNumEnabledAttesters() view returns(uint256)
+func (_MessageTransmitter *MessageTransmitterCaller) GetNumEnabledAttesters(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MessageTransmitter.contract.Call(opts, &out, "getNumEnabledAttesters")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetNumEnabledAttesters is a free data retrieval call binding the contract method 0x51079a53.
+//
+// Solidity: function getNumEnabledAttesters() view returns(uint256)
+func (_MessageTransmitter *MessageTransmitterSession) GetNumEnabledAttesters() (*big.Int, error) {
+	return _MessageTransmitter.Contract.GetNumEnabledAttesters(&_MessageTransmitter.CallOpts)
+}
+
+// GetNumEnabledAttesters is a free data retrieval call binding the contract method 0x51079a53.
+//
+// Solidity: function getNumEnabledAttesters() view returns(uint256)
+func (_MessageTransmitter *MessageTransmitterCallerSession) GetNumEnabledAttesters() (*big.Int, error) {
+	return _MessageTransmitter.Contract.GetNumEnabledAttesters(&_MessageTransmitter.CallOpts)
+}
+
+// IsEnabledAttester is a free data retrieval call binding the contract method 0x7af82f60.
+//
+// Solidity: function isEnabledAttester(address attester) view returns(bool)
+func (_MessageTransmitter *MessageTransmitterCaller) IsEnabledAttester(opts *bind.CallOpts, attester common.Address) (bool, error) {
+	var out []interface{}
+	err := _MessageTransmitter.contract.Call(opts, &out, "isEnabledAttester", attester)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsEnabledAttester is a free data retrieval call binding the contract method 0x7af82f60.
+//
+// Solidity: function isEnabledAttester(address attester) view returns(bool)
+func (_MessageTransmitter *MessageTransmitterSession) IsEnabledAttester(attester common.Address) (bool, error) {
+	return _MessageTransmitter.Contract.IsEnabledAttester(&_MessageTransmitter.CallOpts, attester)
+}
+
+// IsEnabledAttester is a free data retrieval call binding the contract method 0x7af82f60.
+//
+// Solidity: function isEnabledAttester(address attester) view returns(bool)
+func (_MessageTransmitter *MessageTransmitterCallerSession) IsEnabledAttester(attester common.Address) (bool, error) {
+	return _MessageTransmitter.Contract.IsEnabledAttester(&_MessageTransmitter.CallOpts, attester)
+}
+
+// LocalDomain is a free data retrieval call binding the contract method 0x8d3638f4.
+//
+// Solidity: function localDomain() view returns(uint32)
+func (_MessageTransmitter *MessageTransmitterCaller) LocalDomain(opts *bind.CallOpts) (uint32, error) {
+	var out []interface{}
+	err := _MessageTransmitter.contract.Call(opts, &out, "localDomain")
+
+	if err != nil {
+		return *new(uint32), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint32)).(*uint32)
+
+	return out0, err
+
+}
+
+// LocalDomain is a free data retrieval call binding the contract method 0x8d3638f4.
+//
+// Solidity: function localDomain() view returns(uint32)
+func (_MessageTransmitter *MessageTransmitterSession) LocalDomain() (uint32, error) {
+	return _MessageTransmitter.Contract.LocalDomain(&_MessageTransmitter.CallOpts)
+}
+
+// LocalDomain is a free data retrieval call binding the contract method 0x8d3638f4.
+//
+// Solidity: function localDomain() view returns(uint32)
+func (_MessageTransmitter *MessageTransmitterCallerSession) LocalDomain() (uint32, error) {
+	return _MessageTransmitter.Contract.LocalDomain(&_MessageTransmitter.CallOpts)
+}
+
+// MaxMessageBodySize is a free data retrieval call binding the contract method 0xaf47b9bb.
+//
+// Solidity: function maxMessageBodySize() view returns(uint256)
+func (_MessageTransmitter *MessageTransmitterCaller) MaxMessageBodySize(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MessageTransmitter.contract.Call(opts, &out, "maxMessageBodySize")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxMessageBodySize is a free data retrieval call binding the contract method 0xaf47b9bb.
+//
+// Solidity: function maxMessageBodySize() view returns(uint256)
+func (_MessageTransmitter *MessageTransmitterSession) MaxMessageBodySize() (*big.Int, error) {
+	return _MessageTransmitter.Contract.MaxMessageBodySize(&_MessageTransmitter.CallOpts)
+}
+
+// MaxMessageBodySize is a free data retrieval call binding the contract method 0xaf47b9bb.
+//
+// Solidity: function maxMessageBodySize() view returns(uint256)
+func (_MessageTransmitter *MessageTransmitterCallerSession) MaxMessageBodySize() (*big.Int, error) {
+	return _MessageTransmitter.Contract.MaxMessageBodySize(&_MessageTransmitter.CallOpts)
+}
+
+// NextAvailableNonce is a free data retrieval call binding the contract method 0x8371744e.
+//
+// Solidity: function nextAvailableNonce() view returns(uint64)
+func (_MessageTransmitter *MessageTransmitterCaller) NextAvailableNonce(opts *bind.CallOpts) (uint64, error) {
+	var out []interface{}
+	err := _MessageTransmitter.contract.Call(opts, &out, "nextAvailableNonce")
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+// NextAvailableNonce is a free data retrieval call binding the contract method 0x8371744e.
+//
+// Solidity: function nextAvailableNonce() view returns(uint64)
+func (_MessageTransmitter *MessageTransmitterSession) NextAvailableNonce() (uint64, error) {
+	return _MessageTransmitter.Contract.NextAvailableNonce(&_MessageTransmitter.CallOpts)
+}
+
+// NextAvailableNonce is a free data retrieval call binding the contract method 0x8371744e.
+//
+// Solidity: function nextAvailableNonce() view returns(uint64)
+func (_MessageTransmitter *MessageTransmitterCallerSession) NextAvailableNonce() (uint64, error) {
+	return _MessageTransmitter.Contract.NextAvailableNonce(&_MessageTransmitter.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_MessageTransmitter *MessageTransmitterCaller) Paused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _MessageTransmitter.contract.Call(opts, &out, "paused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_MessageTransmitter *MessageTransmitterSession) Paused() (bool, error) {
+	return _MessageTransmitter.Contract.Paused(&_MessageTransmitter.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_MessageTransmitter *MessageTransmitterCallerSession) Paused() (bool, error) {
+	return _MessageTransmitter.Contract.Paused(&_MessageTransmitter.CallOpts)
+}
+
+// SignatureThreshold is a free data retrieval call binding the contract method 0xa82f2e26.
+//
+// Solidity: function signatureThreshold() view returns(uint256)
+func (_MessageTransmitter *MessageTransmitterCaller) SignatureThreshold(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MessageTransmitter.contract.Call(opts, &out, "signatureThreshold")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// SignatureThreshold is a free data retrieval call binding the contract method 0xa82f2e26.
+//
+// Solidity: function signatureThreshold() view returns(uint256)
+func (_MessageTransmitter *MessageTransmitterSession) SignatureThreshold() (*big.Int, error) {
+	return _MessageTransmitter.Contract.SignatureThreshold(&_MessageTransmitter.CallOpts)
+}
+
+// SignatureThreshold is a free data retrieval call binding the contract method 0xa82f2e26.
+//
+// Solidity: function signatureThreshold() view returns(uint256)
+func (_MessageTransmitter *MessageTransmitterCallerSession) SignatureThreshold() (*big.Int, error) {
+	return _MessageTransmitter.Contract.SignatureThreshold(&_MessageTransmitter.CallOpts)
+}
+
+// UsedNonces is a free data retrieval call binding the contract method 0xfeb61724.
+//
+// Solidity: function usedNonces(bytes32 ) view returns(uint256)
+func (_MessageTransmitter *MessageTransmitterCaller) UsedNonces(opts *bind.CallOpts, arg0 [32]byte) (*big.Int, error) {
+	var out []interface{}
+	err := _MessageTransmitter.contract.Call(opts, &out, "usedNonces", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedNonces is a free data retrieval call binding the contract method 0xfeb61724.
+//
+// Solidity: function usedNonces(bytes32 ) view returns(uint256)
+func (_MessageTransmitter *MessageTransmitterSession) UsedNonces(arg0 [32]byte) (*big.Int, error) {
+	return _MessageTransmitter.Contract.UsedNonces(&_MessageTransmitter.CallOpts, arg0)
+}
+
+// UsedNonces is a free data retrieval call binding the contract method 0xfeb61724.
+//
+// Solidity: function usedNonces(bytes32 ) view returns(uint256)
+func (_MessageTransmitter *MessageTransmitterCallerSession) UsedNonces(arg0 [32]byte) (*big.Int, error) {
+	return _MessageTransmitter.Contract.UsedNonces(&_MessageTransmitter.CallOpts, arg0)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() view returns(uint32)
+func (_MessageTransmitter *MessageTransmitterCaller) Version(opts *bind.CallOpts) (uint32, error) {
+	var out []interface{}
+	err := _MessageTransmitter.contract.Call(opts, &out, "version")
+
+	if err != nil {
+		return *new(uint32), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint32)).(*uint32)
+
+	return out0, err
+
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() view returns(uint32)
+func (_MessageTransmitter *MessageTransmitterSession) Version() (uint32, error) {
+	return _MessageTransmitter.Contract.Version(&_MessageTransmitter.CallOpts)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() view returns(uint32)
+func (_MessageTransmitter *MessageTransmitterCallerSession) Version() (uint32, error) {
+	return _MessageTransmitter.Contract.Version(&_MessageTransmitter.CallOpts)
+}
+
+// ReceiveMessage is a paid mutator transaction binding the contract method 0x57ecfd28.
+//
+// Solidity: function receiveMessage(bytes message, bytes attestation) returns(bool success)
+func (_MessageTransmitter *MessageTransmitterTransactor) ReceiveMessage(opts *bind.TransactOpts, message []byte, attestation []byte) (*types.Transaction, error) {
+	return _MessageTransmitter.contract.Transact(opts, "receiveMessage", message, attestation)
+}
+
+// ReceiveMessage is a paid mutator transaction binding the contract method 0x57ecfd28.
+//
+// Solidity: function receiveMessage(bytes message, bytes attestation) returns(bool success)
+func (_MessageTransmitter *MessageTransmitterSession) ReceiveMessage(message []byte, attestation []byte) (*types.Transaction, error) {
+	return _MessageTransmitter.Contract.ReceiveMessage(&_MessageTransmitter.TransactOpts, message, attestation)
+}
+
+// ReceiveMessage is a paid mutator transaction binding the contract method 0x57ecfd28.
+//
+// Solidity: function receiveMessage(bytes message, bytes attestation) returns(bool success)
+func (_MessageTransmitter *MessageTransmitterTransactorSession) ReceiveMessage(message []byte, attestation []byte) (*types.Transaction, error) {
+	return _MessageTransmitter.Contract.ReceiveMessage(&_MessageTransmitter.TransactOpts, message, attestation)
+}
+
+// ReplaceMessage is a paid mutator transaction binding the contract method 0xb857b774.
+//
+// Solidity: function replaceMessage(bytes originalMessage, bytes originalAttestation, bytes newMessageBody, bytes32 newDestinationCaller) returns()
+func (_MessageTransmitter *MessageTransmitterTransactor) ReplaceMessage(opts *bind.TransactOpts, originalMessage []byte, originalAttestation []byte, newMessageBody []byte, newDestinationCaller [32]byte) (*types.Transaction, error) {
+	return _MessageTransmitter.contract.Transact(opts, "replaceMessage", originalMessage, originalAttestation, newMessageBody, newDestinationCaller)
+}
+
+// ReplaceMessage is a paid mutator transaction binding the contract method 0xb857b774.
+//
+// Solidity: function replaceMessage(bytes originalMessage, bytes originalAttestation, bytes newMessageBody, bytes32 newDestinationCaller) returns()
+func (_MessageTransmitter *MessageTransmitterSession) ReplaceMessage(originalMessage []byte, originalAttestation []byte, newMessageBody []byte, newDestinationCaller [32]byte) (*types.Transaction, error) {
+	return _MessageTransmitter.Contract.ReplaceMessage(&_MessageTransmitter.TransactOpts, originalMessage, originalAttestation, newMessageBody, newDestinationCaller)
+}
+
+// ReplaceMessage is a paid mutator transaction binding the contract method 0xb857b774.
+//
+// Solidity: function replaceMessage(bytes originalMessage, bytes originalAttestation, bytes newMessageBody, bytes32 newDestinationCaller) returns()
+func (_MessageTransmitter *MessageTransmitterTransactorSession) ReplaceMessage(originalMessage []byte, originalAttestation []byte, newMessageBody []byte, newDestinationCaller [32]byte) (*types.Transaction, error) {
+	return _MessageTransmitter.Contract.ReplaceMessage(&_MessageTransmitter.TransactOpts, originalMessage, originalAttestation, newMessageBody, newDestinationCaller)
+}
+
+// SendMessage is a paid mutator transaction binding the contract method 0x0ba469bc.
+//
+// Solidity: function sendMessage(uint32 destinationDomain, bytes32 recipient, bytes messageBody) returns(uint64)
+func (_MessageTransmitter *MessageTransmitterTransactor) SendMessage(opts *bind.TransactOpts, destinationDomain uint32, recipient [32]byte, messageBody []byte) (*types.Transaction, error) {
+	return _MessageTransmitter.contract.Transact(opts, "sendMessage", destinationDomain, recipient, messageBody)
+}
+
+// SendMessage is a paid mutator transaction binding the contract method 0x0ba469bc.
+//
+// Solidity: function sendMessage(uint32 destinationDomain, bytes32 recipient, bytes messageBody) returns(uint64)
+func (_MessageTransmitter *MessageTransmitterSession) SendMessage(destinationDomain uint32, recipient [32]byte, messageBody []byte) (*types.Transaction, error) {
+	return _MessageTransmitter.Contract.SendMessage(&_MessageTransmitter.TransactOpts, destinationDomain, recipient, messageBody)
+}
+
+// SendMessage is a paid mutator transaction binding the contract method 0x0ba469bc.
+//
+// Solidity: function sendMessage(uint32 destinationDomain, bytes32 recipient, bytes messageBody) returns(uint64)
+func (_MessageTransmitter *MessageTransmitterTransactorSession) SendMessage(destinationDomain uint32, recipient [32]byte, messageBody []byte) (*types.Transaction, error) {
+	return _MessageTransmitter.Contract.SendMessage(&_MessageTransmitter.TransactOpts, destinationDomain, recipient, messageBody)
+}
+
+// SendMessageWithCaller is a paid mutator transaction binding the contract method 0xf7259a75.
+//
+// Solidity: function sendMessageWithCaller(uint32 destinationDomain, bytes32 recipient, bytes32 destinationCaller, bytes messageBody) returns(uint64)
+func (_MessageTransmitter *MessageTransmitterTransactor) SendMessageWithCaller(opts *bind.TransactOpts, destinationDomain uint32, recipient [32]byte, destinationCaller [32]byte, messageBody []byte) (*types.Transaction, error) {
+	return _MessageTransmitter.contract.Transact(opts, "sendMessageWithCaller", destinationDomain, recipient, destinationCaller, messageBody)
+}
+
+// SendMessageWithCaller is a paid mutator transaction binding the contract method 0xf7259a75.
+//
+// Solidity: function sendMessageWithCaller(uint32 destinationDomain, bytes32 recipient, bytes32 destinationCaller, bytes messageBody) returns(uint64)
+func (_MessageTransmitter *MessageTransmitterSession) SendMessageWithCaller(destinationDomain uint32, recipient [32]byte, destinationCaller [32]byte, messageBody []byte) (*types.Transaction, error) {
+	return _MessageTransmitter.Contract.SendMessageWithCaller(&_MessageTransmitter.TransactOpts, destinationDomain, recipient, destinationCaller, messageBody)
+}
+
+// SendMessageWithCaller is a paid mutator transaction binding the contract method 0xf7259a75.
+//
+// Solidity: function sendMessageWithCaller(uint32 destinationDomain, bytes32 recipient, bytes32 destinationCaller, bytes messageBody) returns(uint64)
+func (_MessageTransmitter *MessageTransmitterTransactorSession) SendMessageWithCaller(destinationDomain uint32, recipient [32]byte, destinationCaller [32]byte, messageBody []byte) (*types.Transaction, error) {
+	return _MessageTransmitter.Contract.SendMessageWithCaller(&_MessageTransmitter.TransactOpts, destinationDomain, recipient, destinationCaller, messageBody)
+}
+
+// MessageTransmitterMessageReceivedIterator is returned from FilterMessageReceived and is used to iterate over the raw logs and unpacked data for MessageReceived events raised by the MessageTransmitter contract.
+type MessageTransmitterMessageReceivedIterator struct {
+	Event *MessageTransmitterMessageReceived // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MessageTransmitterMessageReceivedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MessageTransmitterMessageReceived)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MessageTransmitterMessageReceived)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MessageTransmitterMessageReceivedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MessageTransmitterMessageReceivedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MessageTransmitterMessageReceived represents a MessageReceived event raised by the MessageTransmitter contract.
+type MessageTransmitterMessageReceived struct {
+	Caller       common.Address
+	SourceDomain uint32
+	Nonce        uint64
+	Sender       [32]byte
+	MessageBody  []byte
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterMessageReceived is a free log retrieval operation binding the contract event 0x58200b4c34ae05ee816d710053fff3fb75af4395915d3d2a771b24aa10e3cc5d.
+//
+// Solidity: event MessageReceived(address indexed caller, uint32 sourceDomain, uint64 indexed nonce, bytes32 sender, bytes messageBody)
+func (_MessageTransmitter *MessageTransmitterFilterer) FilterMessageReceived(opts *bind.FilterOpts, caller []common.Address, nonce []uint64) (*MessageTransmitterMessageReceivedIterator, error) {
+
+	var callerRule []interface{}
+	for _, callerItem := range caller {
+		callerRule = append(callerRule, callerItem)
+	}
+
+	var nonceRule []interface{}
+	for _, nonceItem := range nonce {
+		nonceRule = append(nonceRule, nonceItem)
+	}
+
+	logs, sub, err := _MessageTransmitter.contract.FilterLogs(opts, "MessageReceived", callerRule, nonceRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MessageTransmitterMessageReceivedIterator{contract: _MessageTransmitter.contract, event: "MessageReceived", logs: logs, sub: sub}, nil
+}
+
+// WatchMessageReceived is a free log subscription operation binding the contract event 0x58200b4c34ae05ee816d710053fff3fb75af4395915d3d2a771b24aa10e3cc5d.
+//
+// Solidity: event MessageReceived(address indexed caller, uint32 sourceDomain, uint64 indexed nonce, bytes32 sender, bytes messageBody)
+func (_MessageTransmitter *MessageTransmitterFilterer) WatchMessageReceived(opts *bind.WatchOpts, sink chan<- *MessageTransmitterMessageReceived, caller []common.Address, nonce []uint64) (event.Subscription, error) {
+
+	var callerRule []interface{}
+	for _, callerItem := range caller {
+		callerRule = append(callerRule, callerItem)
+	}
+
+	var nonceRule []interface{}
+	for _, nonceItem := range nonce {
+		nonceRule = append(nonceRule, nonceItem)
+	}
+
+	logs, sub, err := _MessageTransmitter.contract.WatchLogs(opts, "MessageReceived", callerRule, nonceRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MessageTransmitterMessageReceived)
+				if err := _MessageTransmitter.contract.UnpackLog(event, "MessageReceived", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMessageReceived is a log parse operation binding the contract event 0x58200b4c34ae05ee816d710053fff3fb75af4395915d3d2a771b24aa10e3cc5d.
+//
+// Solidity: event MessageReceived(address indexed caller, uint32 sourceDomain, uint64 indexed nonce, bytes32 sender, bytes messageBody)
+func (_MessageTransmitter *MessageTransmitterFilterer) ParseMessageReceived(log types.Log) (*MessageTransmitterMessageReceived, error) {
+	event := new(MessageTransmitterMessageReceived)
+	if err := _MessageTransmitter.contract.UnpackLog(event, "MessageReceived", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MessageTransmitterMessageSentIterator is returned from FilterMessageSent and is used to iterate over the raw logs and unpacked data for MessageSent events raised by the MessageTransmitter contract.
+type MessageTransmitterMessageSentIterator struct {
+	Event *MessageTransmitterMessageSent // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MessageTransmitterMessageSentIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MessageTransmitterMessageSent)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MessageTransmitterMessageSent)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MessageTransmitterMessageSentIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MessageTransmitterMessageSentIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MessageTransmitterMessageSent represents a MessageSent event raised by the MessageTransmitter contract.
+type MessageTransmitterMessageSent struct {
+	Message []byte
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterMessageSent is a free log retrieval operation binding the contract event 0x8c5261668696ce22758910d05bab8f186d6eb247ceac2af2e82c7dc17669b036.
+//
+// Solidity: event MessageSent(bytes message)
+func (_MessageTransmitter *MessageTransmitterFilterer) FilterMessageSent(opts *bind.FilterOpts) (*MessageTransmitterMessageSentIterator, error) {
+
+	logs, sub, err := _MessageTransmitter.contract.FilterLogs(opts, "MessageSent")
+	if err != nil {
+		return nil, err
+	}
+	return &MessageTransmitterMessageSentIterator{contract: _MessageTransmitter.contract, event: "MessageSent", logs: logs, sub: sub}, nil
+}
+
+// WatchMessageSent is a free log subscription operation binding the contract event 0x8c5261668696ce22758910d05bab8f186d6eb247ceac2af2e82c7dc17669b036.
+//
+// Solidity: event MessageSent(bytes message)
+func (_MessageTransmitter *MessageTransmitterFilterer) WatchMessageSent(opts *bind.WatchOpts, sink chan<- *MessageTransmitterMessageSent) (event.Subscription, error) {
+
+	logs, sub, err := _MessageTransmitter.contract.WatchLogs(opts, "MessageSent")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MessageTransmitterMessageSent)
+				if err := _MessageTransmitter.contract.UnpackLog(event, "MessageSent", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMessageSent is a log parse operation binding the contract event 0x8c5261668696ce22758910d05bab8f186d6eb247ceac2af2e82c7dc17669b036.
+//
+// Solidity: event MessageSent(bytes message)
+func (_MessageTransmitter *MessageTransmitterFilterer) ParseMessageSent(log types.Log) (*MessageTransmitterMessageSent, error) {
+	event := new(MessageTransmitterMessageSent)
+	if err := _MessageTransmitter.contract.UnpackLog(event, "MessageSent", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,712 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package MessageTransmitterV2
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// MessageTransmitterV2MetaData contains all meta data concerning the MessageTransmitterV2 contract.
+var MessageTransmitterV2MetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"receiveMessage\",\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"message\",\"type\":\"bytes\"},{\"name\":\"attestation\",\"type\":\"bytes\"}],\"outputs\":[{\"name\":\"success\",\"type\":\"bool\"}]},{\"type\":\"function\",\"name\":\"sendMessage\",\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"destinationDomain\",\"type\":\"uint32\"},{\"name\":\"recipient\",\"type\":\"bytes32\"},{\"name\":\"destinationCaller\",\"type\":\"bytes32\"},{\"name\":\"minFinalityThreshold\",\"type\":\"uint32\"},{\"name\":\"messageBody\",\"type\":\"bytes\"}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"usedNonces\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"\",\"type\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"localDomain\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint32\"}]},{\"type\":\"function\",\"name\":\"version\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint32\"}]},{\"type\":\"function\",\"name\":\"maxMessageBodySize\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"paused\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}]},{\"type\":\"function\",\"name\":\"signatureThreshold\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}]},{\"type\":\"event\",\"name\":\"MessageSent\",\"anonymous\":false,\"inputs\":[{\"name\":\"message\",\"type\":\"bytes\",\"indexed\":false}]},{\"type\":\"event\",\"name\":\"MessageReceived\",\"anonymous\":false,\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"indexed\":true},{\"name\":\"sourceDomain\",\"type\":\"uint32\",\"indexed\":false},{\"name\":\"nonce\",\"type\":\"bytes32\",\"indexed\":true},{\"name\":\"sender\",\"type\":\"bytes32\",\"indexed\":false},{\"name\":\"finalityThresholdExecuted\",\"type\":\"uint32\",\"indexed\":true},{\"name\":\"messageBody\",\"type\":\"bytes\",\"indexed\":false}]}]",
+}
+
+// MessageTransmitterV2ABI is the input ABI used to generate the binding from.
+// Deprecated: Use MessageTransmitterV2MetaData.ABI instead.
+var MessageTransmitterV2ABI = MessageTransmitterV2MetaData.ABI
+
+// MessageTransmitterV2 is an auto generated Go binding around an Ethereum contract.
+type MessageTransmitterV2 struct {
+	MessageTransmitterV2Caller     // Read-only binding to the contract
+	MessageTransmitterV2Transactor // Write-only binding to the contract
+	MessageTransmitterV2Filterer   // Log filterer for contract events
+}
+
+// MessageTransmitterV2Caller is an auto generated read-only Go binding around an Ethereum contract.
+type MessageTransmitterV2Caller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MessageTransmitterV2Transactor is an auto generated write-only Go binding around an Ethereum contract.
+type MessageTransmitterV2Transactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MessageTransmitterV2Filterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type MessageTransmitterV2Filterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MessageTransmitterV2Session is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type MessageTransmitterV2Session struct {
+	Contract     *MessageTransmitterV2 // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts         // Call options to use throughout this session
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// MessageTransmitterV2CallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type MessageTransmitterV2CallerSession struct {
+	Contract *MessageTransmitterV2Caller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts               // Call options to use throughout this session
+}
+
+// MessageTransmitterV2TransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type MessageTransmitterV2TransactorSession struct {
+	Contract     *MessageTransmitterV2Transactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts               // Transaction auth options to use throughout this session
+}
+
+// MessageTransmitterV2Raw is an auto generated low-level Go binding around an Ethereum contract.
+type MessageTransmitterV2Raw struct {
+	Contract *MessageTransmitterV2 // Generic contract binding to access the raw methods on
+}
+
+// MessageTransmitterV2CallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type MessageTransmitterV2CallerRaw struct {
+	Contract *MessageTransmitterV2Caller // Generic read-only contract binding to access the raw methods on
+}
+
+// MessageTransmitterV2TransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type MessageTransmitterV2TransactorRaw struct {
+	Contract *MessageTransmitterV2Transactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewMessageTransmitterV2 creates a new instance of MessageTransmitterV2, bound to a specific deployed contract.
+func NewMessageTransmitterV2(address common.Address, backend bind.ContractBackend) (*MessageTransmitterV2, error) {
+	contract, err := bindMessageTransmitterV2(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &MessageTransmitterV2{MessageTransmitterV2Caller: MessageTransmitterV2Caller{contract: contract}, MessageTransmitterV2Transactor: MessageTransmitterV2Transactor{contract: contract}, MessageTransmitterV2Filterer: MessageTransmitterV2Filterer{contract: contract}}, nil
+}
+
+// NewMessageTransmitterV2Caller creates a new read-only instance of MessageTransmitterV2, bound to a specific deployed contract.
+func NewMessageTransmitterV2Caller(address common.Address, caller bind.ContractCaller) (*MessageTransmitterV2Caller, error) {
+	contract, err := bindMessageTransmitterV2(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MessageTransmitterV2Caller{contract: contract}, nil
+}
+
+// NewMessageTransmitterV2Transactor creates a new write-only instance of MessageTransmitterV2, bound to a specific deployed contract.
+func NewMessageTransmitterV2Transactor(address common.Address, transactor bind.ContractTransactor) (*MessageTransmitterV2Transactor, error) {
+	contract, err := bindMessageTransmitterV2(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MessageTransmitterV2Transactor{contract: contract}, nil
+}
+
+// NewMessageTransmitterV2Filterer creates a new log filterer instance of MessageTransmitterV2, bound to a specific deployed contract.
+func NewMessageTransmitterV2Filterer(address common.Address, filterer bind.ContractFilterer) (*MessageTransmitterV2Filterer, error) {
+	contract, err := bindMessageTransmitterV2(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &MessageTransmitterV2Filterer{contract: contract}, nil
+}
+
+// bindMessageTransmitterV2 binds a generic wrapper to an already deployed contract.
+func bindMessageTransmitterV2(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := MessageTransmitterV2MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MessageTransmitterV2 *MessageTransmitterV2Raw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MessageTransmitterV2.Contract.MessageTransmitterV2Caller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MessageTransmitterV2 *MessageTransmitterV2Raw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MessageTransmitterV2.Contract.MessageTransmitterV2Transactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MessageTransmitterV2 *MessageTransmitterV2Raw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MessageTransmitterV2.Contract.MessageTransmitterV2Transactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MessageTransmitterV2 *MessageTransmitterV2CallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MessageTransmitterV2.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MessageTransmitterV2 *MessageTransmitterV2TransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MessageTransmitterV2.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MessageTransmitterV2 *MessageTransmitterV2TransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MessageTransmitterV2.Contract.contract.Transact(opts, method, params...)
+}
+
+// LocalDomain is a free data retrieval call binding the contract method 0x8d3638f4.
+//
+// Solidity: function localDomain() view returns(uint32)
+func (_MessageTransmitterV2 *MessageTransmitterV2Caller) LocalDomain(opts *bind.CallOpts) (uint32, error) {
+	var out []interface{}
+	err := _MessageTransmitterV2.contract.Call(opts, &out, "localDomain")
+
+	if err != nil {
+		return *new(uint32), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint32)).(*uint32)
+
+	return out0, err
+
+}
+
+// LocalDomain is a free data retrieval call binding the contract method 0x8d3638f4.
+//
+// Solidity: function localDomain() view returns(uint32)
+func (_MessageTransmitterV2 *MessageTransmitterV2Session) LocalDomain() (uint32, error) {
+	return _MessageTransmitterV2.Contract.LocalDomain(&_MessageTransmitterV2.CallOpts)
+}
+
+// LocalDomain is a free data retrieval call binding the contract method 0x8d3638f4.
+//
+// Solidity: function localDomain() view returns(uint32)
+func (_MessageTransmitterV2 *MessageTransmitterV2CallerSession) LocalDomain() (uint32, error) {
+	return _MessageTransmitterV2.Contract.LocalDomain(&_MessageTransmitterV2.CallOpts)
+}
+
+// MaxMessageBodySize is a free data retrieval call binding the contract method 0xaf47b9bb.
+//
+// Solidity: function maxMessageBodySize() view returns(uint256)
+func (_MessageTransmitterV2 *MessageTransmitterV2Caller) MaxMessageBodySize(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MessageTransmitterV2.contract.Call(opts, &out, "maxMessageBodySize")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxMessageBodySize is a free data retrieval call binding the contract method 0xaf47b9bb.
+//
+// Solidity: function maxMessageBodySize() view returns(uint256)
+func (_MessageTransmitterV2 *MessageTransmitterV2Session) MaxMessageBodySize() (*big.Int, error) {
+	return _MessageTransmitterV2.Contract.MaxMessageBodySize(&_MessageTransmitterV2.CallOpts)
+}
+
+// MaxMessageBodySize is a free data retrieval call binding the contract method 0xaf47b9bb.
+//
+// Solidity: function maxMessageBodySize() view returns(uint256)
+func (_MessageTransmitterV2 *MessageTransmitterV2CallerSession) MaxMessageBodySize() (*big.Int, error) {
+	return _MessageTransmitterV2.Contract.MaxMessageBodySize(&_MessageTransmitterV2.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_MessageTransmitterV2 *MessageTransmitterV2Caller) Paused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _MessageTransmitterV2.contract.Call(opts, &out, "paused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_MessageTransmitterV2 *MessageTransmitterV2Session) Paused() (bool, error) {
+	return _MessageTransmitterV2.Contract.Paused(&_MessageTransmitterV2.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_MessageTransmitterV2 *MessageTransmitterV2CallerSession) Paused() (bool, error) {
+	return _MessageTransmitterV2.Contract.Paused(&_MessageTransmitterV2.CallOpts)
+}
+
+// SignatureThreshold is a free data retrieval call binding the contract method 0xa82f2e26.
+//
+// Solidity: function signatureThreshold() view returns(uint256)
+func (_MessageTransmitterV2 *MessageTransmitterV2Caller) SignatureThreshold(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MessageTransmitterV2.contract.Call(opts, &out, "signatureThreshold")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// SignatureThreshold is a free data retrieval call binding the contract method 0xa82f2e26.
+//
+// Solidity: function signatureThreshold() view returns(uint256)
+func (_MessageTransmitterV2 *MessageTransmitterV2Session) SignatureThreshold() (*big.Int, error) {
+	return _MessageTransmitterV2.Contract.SignatureThreshold(&_MessageTransmitterV2.CallOpts)
+}
+
+// SignatureThreshold is a free data retrieval call binding the contract method 0xa82f2e26.
+//
+// Solidity: function signatureThreshold() view returns(uint256)
+func (_MessageTransmitterV2 *MessageTransmitterV2CallerSession) SignatureThreshold() (*big.Int, error) {
+	return _MessageTransmitterV2.Contract.SignatureThreshold(&_MessageTransmitterV2.CallOpts)
+}
+
+// UsedNonces is a free data retrieval call binding the contract method 0xfeb61724.
+//
+// Solidity: function usedNonces(bytes32 ) view returns(uint256)
+func (_MessageTransmitterV2 *MessageTransmitterV2Caller) UsedNonces(opts *bind.CallOpts, arg0 [32]byte) (*big.Int, error) {
+	var out []interface{}
+	err := _MessageTransmitterV2.contract.Call(opts, &out, "usedNonces", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedNonces is a free data retrieval call binding the contract method 0xfeb61724.
+//
+// Solidity: function usedNonces(bytes32 ) view returns(uint256)
+func (_MessageTransmitterV2 *MessageTransmitterV2Session) UsedNonces(arg0 [32]byte) (*big.Int, error) {
+	return _MessageTransmitterV2.Contract.UsedNonces(&_MessageTransmitterV2.CallOpts, arg0)
+}
+
+// UsedNonces is a free data retrieval call binding the contract method 0xfeb61724.
+//
+// Solidity: function usedNonces(bytes32 ) view returns(uint256)
+func (_MessageTransmitterV2 *MessageTransmitterV2CallerSession) UsedNonces(arg0 [32]byte) (*big.Int, error) {
+	return _MessageTransmitterV2.Contract.UsedNonces(&_MessageTransmitterV2.CallOpts, arg0)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() view returns(uint32)
+func (_MessageTransmitterV2 *MessageTransmitterV2Caller) Version(opts *bind.CallOpts) (uint32, error) {
+	var out []interface{}
+	err := _MessageTransmitterV2.contract.Call(opts, &out, "version")
+
+	if err != nil {
+		return *new(uint32), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint32)).(*uint32)
+
+	return out0, err
+
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() view returns(uint32)
+func (_MessageTransmitterV2 *MessageTransmitterV2Session) Version() (uint32, error) {
+	return _MessageTransmitterV2.Contract.Version(&_MessageTransmitterV2.CallOpts)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() view returns(uint32)
+func (_MessageTransmitterV2 *MessageTransmitterV2CallerSession) Version() (uint32, error) {
+	return _MessageTransmitterV2.Contract.Version(&_MessageTransmitterV2.CallOpts)
+}
+
+// ReceiveMessage is a paid mutator transaction binding the contract method 0x57ecfd28.
+//
+// Solidity: function receiveMessage(bytes message, bytes attestation) returns(bool success)
+func (_MessageTransmitterV2 *MessageTransmitterV2Transactor) ReceiveMessage(opts *bind.TransactOpts, message []byte, attestation []byte) (*types.Transaction, error) {
+	return _MessageTransmitterV2.contract.Transact(opts, "receiveMessage", message, attestation)
+}
+
+// ReceiveMessage is a paid mutator transaction binding the contract method 0x57ecfd28.
+//
+// Solidity: function receiveMessage(bytes message, bytes attestation) returns(bool success)
+func (_MessageTransmitterV2 *MessageTransmitterV2Session) ReceiveMessage(message []byte, attestation []byte) (*types.Transaction, error) {
+	return _MessageTransmitterV2.Contract.ReceiveMessage(&_MessageTransmitterV2.TransactOpts, message, attestation)
+}
+
+// ReceiveMessage is a paid mutator transaction binding the contract method 0x57ecfd28.
+//
+// Solidity: function receiveMessage(bytes message, bytes attestation) returns(bool success)
+func (_MessageTransmitterV2 *MessageTransmitterV2TransactorSession) ReceiveMessage(message []byte, attestation []byte) (*types.Transaction, error) {
+	return _MessageTransmitterV2.Contract.ReceiveMessage(&_MessageTransmitterV2.TransactOpts, message, attestation)
+}
+
+// SendMessage is a paid mutator transaction binding the contract method 0x14b157ab.
+//
+// Solidity: function sendMessage(uint32 destinationDomain, bytes32 recipient, bytes32 destinationCaller, uint32 minFinalityThreshold, bytes messageBody) returns()
+func (_MessageTransmitterV2 *MessageTransmitterV2Transactor) SendMessage(opts *bind.TransactOpts, destinationDomain uint32, recipient [32]byte, destinationCaller [32]byte, minFinalityThreshold uint32, messageBody []byte) (*types.Transaction, error) {
+	return _MessageTransmitterV2.contract.Transact(opts, "sendMessage", destinationDomain, recipient, destinationCaller, minFinalityThreshold, messageBody)
+}
+
+// SendMessage is a paid mutator transaction binding the contract method 0x14b157ab.
+//
+// Solidity: function sendMessage(uint32 destinationDomain, bytes32 recipient, bytes32 destinationCaller, uint32 minFinalityThreshold, bytes messageBody) returns()
+func (_MessageTransmitterV2 *MessageTransmitterV2Session) SendMessage(destinationDomain uint32, recipient [32]byte, destinationCaller [32]byte, minFinalityThreshold uint32, messageBody []byte) (*types.Transaction, error) {
+	return _MessageTransmitterV2.Contract.SendMessage(&_MessageTransmitterV2.TransactOpts, destinationDomain, recipient, destinationCaller, minFinalityThreshold, messageBody)
+}
+
+// SendMessage is a paid mutator transaction binding the contract method 0x14b157ab.
+//
+// Solidity: function sendMessage(uint32 destinationDomain, bytes32 recipient, bytes32 destinationCaller, uint32 minFinalityThreshold, bytes messageBody) returns()
+func (_MessageTransmitterV2 *MessageTransmitterV2TransactorSession) SendMessage(destinationDomain uint32, recipient [32]byte, destinationCaller [32]byte, minFinalityThreshold uint32, messageBody []byte) (*types.Transaction, error) {
+	return _MessageTransmitterV2.Contract.SendMessage(&_MessageTransmitterV2.TransactOpts, destinationDomain, recipient, destinationCaller, minFinalityThreshold, messageBody)
+}
+
+// MessageTransmitterV2MessageReceivedIterator is returned from FilterMessageReceived and is used to iterate over the raw logs and unpacked data for MessageReceived events raised by the MessageTransmitterV2 contract.
+type MessageTransmitterV2MessageReceivedIterator struct {
+	Event *MessageTransmitterV2MessageReceived // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MessageTransmitterV2MessageReceivedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MessageTransmitterV2MessageReceived)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MessageTransmitterV2MessageReceived)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MessageTransmitterV2MessageReceivedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MessageTransmitterV2MessageReceivedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MessageTransmitterV2MessageReceived represents a MessageReceived event raised by the MessageTransmitterV2 contract.
+type MessageTransmitterV2MessageReceived struct {
+	Caller                    common.Address
+	SourceDomain              uint32
+	Nonce                     [32]byte
+	Sender                    [32]byte
+	FinalityThresholdExecuted uint32
+	MessageBody               []byte
+	Raw                       types.Log // Blockchain specific contextual infos
+}
+
+// FilterMessageReceived is a free log retrieval operation binding the contract event 0xff48c13eda96b1cceacc6b9edeedc9e9db9d6226afbc30146b720c19d3addb1c.
+//
+// Solidity: event MessageReceived(address indexed caller, uint32 sourceDomain, bytes32 indexed nonce, bytes32 sender, uint32 indexed finalityThresholdExecuted, bytes messageBody)
+func (_MessageTransmitterV2 *MessageTransmitterV2Filterer) FilterMessageReceived(opts *bind.FilterOpts, caller []common.Address, nonce [][32]byte, finalityThresholdExecuted []uint32) (*MessageTransmitterV2MessageReceivedIterator, error) {
+
+	var callerRule []interface{}
+	for _, callerItem := range caller {
+		callerRule = append(callerRule, callerItem)
+	}
+
+	var nonceRule []interface{}
+	for _, nonceItem := range nonce {
+		nonceRule = append(nonceRule, nonceItem)
+	}
+
+	var finalityThresholdExecutedRule []interface{}
+	for _, finalityThresholdExecutedItem := range finalityThresholdExecuted {
+		finalityThresholdExecutedRule = append(finalityThresholdExecutedRule, finalityThresholdExecutedItem)
+	}
+
+	logs, sub, err := _MessageTransmitterV2.contract.FilterLogs(opts, "MessageReceived", callerRule, nonceRule, finalityThresholdExecutedRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MessageTransmitterV2MessageReceivedIterator{contract: _MessageTransmitterV2.contract, event: "MessageReceived", logs: logs, sub: sub}, nil
+}
+
+// WatchMessageReceived is a free log subscription operation binding the contract event 0xff48c13eda96b1cceacc6b9edeedc9e9db9d6226afbc30146b720c19d3addb1c.
+//
+// Solidity: event MessageReceived(address indexed caller, uint32 sourceDomain, bytes32 indexed nonce, bytes32 sender, uint32 indexed finalityThresholdExecuted, bytes messageBody)
+func (_MessageTransmitterV2 *MessageTransmitterV2Filterer) WatchMessageReceived(opts *bind.WatchOpts, sink chan<- *MessageTransmitterV2MessageReceived, caller []common.Address, nonce [][32]byte, finalityThresholdExecuted []uint32) (event.Subscription, error) {
+
+	var callerRule []interface{}
+	for _, callerItem := range caller {
+		callerRule = append(callerRule, callerItem)
+	}
+
+	var nonceRule []interface{}
+	for _, nonceItem := range nonce {
+		nonceRule = append(nonceRule, nonceItem)
+	}
+
+	var finalityThresholdExecutedRule []interface{}
+	for _, finalityThresholdExecutedItem := range finalityThresholdExecuted {
+		finalityThresholdExecutedRule = append(finalityThresholdExecutedRule, finalityThresholdExecutedItem)
+	}
+
+	logs, sub, err := _MessageTransmitterV2.contract.WatchLogs(opts, "MessageReceived", callerRule, nonceRule, finalityThresholdExecutedRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MessageTransmitterV2MessageReceived)
+				if err := _MessageTransmitterV2.contract.UnpackLog(event, "MessageReceived", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMessageReceived is a log parse operation binding the contract event 0xff48c13eda96b1cceacc6b9edeedc9e9db9d6226afbc30146b720c19d3addb1c.
+//
+// Solidity: event MessageReceived(address indexed caller, uint32 sourceDomain, bytes32 indexed nonce, bytes32 sender, uint32 indexed finalityThresholdExecuted, bytes messageBody)
+func (_MessageTransmitterV2 *MessageTransmitterV2Filterer) ParseMessageReceived(log types.Log) (*MessageTransmitterV2MessageReceived, error) {
+	event := new(MessageTransmitterV2MessageReceived)
+	if err := _MessageTransmitterV2.contract.UnpackLog(event, "MessageReceived", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MessageTransmitterV2MessageSentIterator is returned from FilterMessageSent and is used to iterate over the raw logs and unpacked data for MessageSent events raised by the MessageTransmitterV2 contract.
+type MessageTransmitterV2MessageSentIterator struct {
+	Event *MessageTransmitterV2MessageSent // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MessageTransmitterV2MessageSentIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MessageTransmitterV2MessageSent)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MessageTransmitterV2MessageSent)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MessageTransmitterV2MessageSentIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MessageTransmitterV2MessageSentIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MessageTransmitterV2MessageSent represents a MessageSent event raised by the MessageTransmitterV2 contract.
+type MessageTransmitterV2MessageSent struct {
+	Message []byte
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterMessageSent is a free log retrieval operation binding the contract event 0x8c5261668696ce22758910d05bab8f186d6eb247ceac2af2e82c7dc17669b036.
+//
+// Solidity: event MessageSent(bytes message)
+func (_MessageTransmitterV2 *MessageTransmitterV2Filterer) FilterMessageSent(opts *bind.FilterOpts) (*MessageTransmitterV2MessageSentIterator, error) {
+
+	logs, sub, err := _MessageTransmitterV2.contract.FilterLogs(opts, "MessageSent")
+	if err != nil {
+		return nil, err
+	}
+	return &MessageTransmitterV2MessageSentIterator{contract: _MessageTransmitterV2.contract, event: "MessageSent", logs: logs, sub: sub}, nil
+}
+
+// WatchMessageSent is a free log subscription operation binding the contract event 0x8c5261668696ce22758910d05bab8f186d6eb247ceac2af2e82c7dc17669b036.
+//
+// Solidity: event MessageSent(bytes message)
+func (_MessageTransmitterV2 *MessageTransmitterV2Filterer) WatchMessageSent(opts *bind.WatchOpts, sink chan<- *MessageTransmitterV2MessageSent) (event.Subscription, error) {
+
+	logs, sub, err := _MessageTransmitterV2.contract.WatchLogs(opts, "MessageSent")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MessageTransmitterV2MessageSent)
+				if err := _MessageTransmitterV2.contract.UnpackLog(event, "MessageSent", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMessageSent is a log parse operation binding the contract event 0x8c5261668696ce22758910d05bab8f186d6eb247ceac2af2e82c7dc17669b036.
+//
+// Solidity: event MessageSent(bytes message)
+func (_MessageTransmitterV2 *MessageTransmitterV2Filterer) ParseMessageSent(log types.Log) (*MessageTransmitterV2MessageSent, error) {
+	event := new(MessageTransmitterV2MessageSent)
+	if err := _MessageTransmitterV2.contract.UnpackLog(event, "MessageSent", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
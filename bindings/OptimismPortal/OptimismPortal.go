@@ -0,0 +1,1383 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package OptimismPortal
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// TypesOutputRootProof is an auto generated low-level Go binding around an user-defined struct.
+type TypesOutputRootProof struct {
+	Version                  [32]byte
+	StateRoot                [32]byte
+	MessagePasserStorageRoot [32]byte
+	LatestBlockhash          [32]byte
+}
+
+// TypesWithdrawalTransaction is an auto generated low-level Go binding around an user-defined struct.
+type TypesWithdrawalTransaction struct {
+	Nonce    *big.Int
+	Sender   common.Address
+	Target   common.Address
+	Value    *big.Int
+	GasLimit *big.Int
+	Data     []byte
+}
+
+// OptimismPortalMetaData contains all meta data concerning the OptimismPortal contract.
+var OptimismPortalMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"proveWithdrawalTransaction\",\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"_tx\",\"type\":\"tuple\",\"internalType\":\"structTypes.WithdrawalTransaction\",\"components\":[{\"name\":\"nonce\",\"type\":\"uint256\"},{\"name\":\"sender\",\"type\":\"address\"},{\"name\":\"target\",\"type\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\"},{\"name\":\"gasLimit\",\"type\":\"uint256\"},{\"name\":\"data\",\"type\":\"bytes\"}]},{\"name\":\"_disputeGameIndex\",\"type\":\"uint256\"},{\"name\":\"_outputRootProof\",\"type\":\"tuple\",\"internalType\":\"structTypes.OutputRootProof\",\"components\":[{\"name\":\"version\",\"type\":\"bytes32\"},{\"name\":\"stateRoot\",\"type\":\"bytes32\"},{\"name\":\"messagePasserStorageRoot\",\"type\":\"bytes32\"},{\"name\":\"latestBlockhash\",\"type\":\"bytes32\"}]},{\"name\":\"_withdrawalProof\",\"type\":\"bytes[]\"}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"finalizeWithdrawalTransaction\",\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"_tx\",\"type\":\"tuple\",\"internalType\":\"structTypes.WithdrawalTransaction\",\"components\":[{\"name\":\"nonce\",\"type\":\"uint256\"},{\"name\":\"sender\",\"type\":\"address\"},{\"name\":\"target\",\"type\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\"},{\"name\":\"gasLimit\",\"type\":\"uint256\"},{\"name\":\"data\",\"type\":\"bytes\"}]}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"finalizeWithdrawalTransactionExternalProof\",\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"_tx\",\"type\":\"tuple\",\"internalType\":\"structTypes.WithdrawalTransaction\",\"components\":[{\"name\":\"nonce\",\"type\":\"uint256\"},{\"name\":\"sender\",\"type\":\"address\"},{\"name\":\"target\",\"type\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\"},{\"name\":\"gasLimit\",\"type\":\"uint256\"},{\"name\":\"data\",\"type\":\"bytes\"}]},{\"name\":\"_proofSubmitter\",\"type\":\"address\"}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"checkWithdrawal\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"_withdrawalHash\",\"type\":\"bytes32\"},{\"name\":\"_proofSubmitter\",\"type\":\"address\"}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"provenWithdrawals\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"\",\"type\":\"bytes32\"},{\"name\":\"\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"disputeGameProxy\",\"type\":\"address\"},{\"name\":\"timestamp\",\"type\":\"uint64\"}]},{\"type\":\"function\",\"name\":\"finalizedWithdrawals\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"\",\"type\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}]},{\"type\":\"function\",\"name\":\"numProofSubmitters\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"_withdrawalHash\",\"type\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"proofSubmitters\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"\",\"type\":\"bytes32\"},{\"name\":\"\",\"type\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\"}]},{\"type\":\"function\",\"name\":\"disputeGameFactory\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\"}]},{\"type\":\"function\",\"name\":\"respectedGameType\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint32\"}]},{\"type\":\"function\",\"name\":\"respectedGameTypeUpdatedAt\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint64\"}]},{\"type\":\"function\",\"name\":\"proofMaturityDelaySeconds\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"disputeGameFinalityDelaySeconds\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"disputeGameBlacklist\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}]},{\"type\":\"function\",\"name\":\"depositTransaction\",\"stateMutability\":\"payable\",\"inputs\":[{\"name\":\"_to\",\"type\":\"address\"},{\"name\":\"_value\",\"type\":\"uint256\"},{\"name\":\"_gasLimit\",\"type\":\"uint64\"},{\"name\":\"_isCreation\",\"type\":\"bool\"},{\"name\":\"_data\",\"type\":\"bytes\"}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"l2Sender\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\"}]},{\"type\":\"function\",\"name\":\"paused\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}]},{\"type\":\"function\",\"name\":\"systemConfig\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\"}]},{\"type\":\"function\",\"name\":\"version\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\"}]},{\"type\":\"event\",\"name\":\"TransactionDeposited\",\"anonymous\":false,\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"indexed\":true},{\"name\":\"to\",\"type\":\"address\",\"indexed\":true},{\"name\":\"version\",\"type\":\"uint256\",\"indexed\":true},{\"name\":\"opaqueData\",\"type\":\"bytes\",\"indexed\":false}]},{\"type\":\"event\",\"name\":\"WithdrawalProven\",\"anonymous\":false,\"inputs\":[{\"name\":\"withdrawalHash\",\"type\":\"bytes32\",\"indexed\":true},{\"name\":\"from\",\"type\":\"address\",\"indexed\":true},{\"name\":\"to\",\"type\":\"address\",\"indexed\":true}]},{\"type\":\"event\",\"name\":\"WithdrawalProvenExtension1\",\"anonymous\":false,\"inputs\":[{\"name\":\"withdrawalHash\",\"type\":\"bytes32\",\"indexed\":true},{\"name\":\"proofSubmitter\",\"type\":\"address\",\"indexed\":true}]},{\"type\":\"event\",\"name\":\"WithdrawalFinalized\",\"anonymous\":false,\"inputs\":[{\"name\":\"withdrawalHash\",\"type\":\"bytes32\",\"indexed\":true},{\"name\":\"success\",\"type\":\"bool\",\"indexed\":false}]}]",
+}
+
+// OptimismPortalABI is the input ABI used to generate the binding from.
+// Deprecated: Use OptimismPortalMetaData.ABI instead.
+var OptimismPortalABI = OptimismPortalMetaData.ABI
+
+// OptimismPortal is an auto generated Go binding around an Ethereum contract.
+type OptimismPortal struct {
+	OptimismPortalCaller     // Read-only binding to the contract
+	OptimismPortalTransactor // Write-only binding to the contract
+	OptimismPortalFilterer   // Log filterer for contract events
+}
+
+// OptimismPortalCaller is an auto generated read-only Go binding around an Ethereum contract.
+type OptimismPortalCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// OptimismPortalTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type OptimismPortalTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// OptimismPortalFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type OptimismPortalFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// OptimismPortalSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type OptimismPortalSession struct {
+	Contract     *OptimismPortal   // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// OptimismPortalCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type OptimismPortalCallerSession struct {
+	Contract *OptimismPortalCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts         // Call options to use throughout this session
+}
+
+// OptimismPortalTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type OptimismPortalTransactorSession struct {
+	Contract     *OptimismPortalTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// OptimismPortalRaw is an auto generated low-level Go binding around an Ethereum contract.
+type OptimismPortalRaw struct {
+	Contract *OptimismPortal // Generic contract binding to access the raw methods on
+}
+
+// OptimismPortalCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type OptimismPortalCallerRaw struct {
+	Contract *OptimismPortalCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// OptimismPortalTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type OptimismPortalTransactorRaw struct {
+	Contract *OptimismPortalTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewOptimismPortal creates a new instance of OptimismPortal, bound to a specific deployed contract.
+func NewOptimismPortal(address common.Address, backend bind.ContractBackend) (*OptimismPortal, error) {
+	contract, err := bindOptimismPortal(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &OptimismPortal{OptimismPortalCaller: OptimismPortalCaller{contract: contract}, OptimismPortalTransactor: OptimismPortalTransactor{contract: contract}, OptimismPortalFilterer: OptimismPortalFilterer{contract: contract}}, nil
+}
+
+// NewOptimismPortalCaller creates a new read-only instance of OptimismPortal, bound to a specific deployed contract.
+func NewOptimismPortalCaller(address common.Address, caller bind.ContractCaller) (*OptimismPortalCaller, error) {
+	contract, err := bindOptimismPortal(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &OptimismPortalCaller{contract: contract}, nil
+}
+
+// NewOptimismPortalTransactor creates a new write-only instance of OptimismPortal, bound to a specific deployed contract.
+func NewOptimismPortalTransactor(address common.Address, transactor bind.ContractTransactor) (*OptimismPortalTransactor, error) {
+	contract, err := bindOptimismPortal(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &OptimismPortalTransactor{contract: contract}, nil
+}
+
+// NewOptimismPortalFilterer creates a new log filterer instance of OptimismPortal, bound to a specific deployed contract.
+func NewOptimismPortalFilterer(address common.Address, filterer bind.ContractFilterer) (*OptimismPortalFilterer, error) {
+	contract, err := bindOptimismPortal(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &OptimismPortalFilterer{contract: contract}, nil
+}
+
+// bindOptimismPortal binds a generic wrapper to an already deployed contract.
+func bindOptimismPortal(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := OptimismPortalMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_OptimismPortal *OptimismPortalRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _OptimismPortal.Contract.OptimismPortalCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_OptimismPortal *OptimismPortalRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _OptimismPortal.Contract.OptimismPortalTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_OptimismPortal *OptimismPortalRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _OptimismPortal.Contract.OptimismPortalTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_OptimismPortal *OptimismPortalCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _OptimismPortal.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_OptimismPortal *OptimismPortalTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _OptimismPortal.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_OptimismPortal *OptimismPortalTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _OptimismPortal.Contract.contract.Transact(opts, method, params...)
+}
+
+// CheckWithdrawal is a free data retrieval call binding the contract method 0x71c1566e.
+//
+// Solidity: function checkWithdrawal(bytes32 _withdrawalHash, address _proofSubmitter) view returns()
+func (_OptimismPortal *OptimismPortalCaller) CheckWithdrawal(opts *bind.CallOpts, _withdrawalHash [32]byte, _proofSubmitter common.Address) error {
+	var out []interface{}
+	err := _OptimismPortal.contract.Call(opts, &out, "checkWithdrawal", _withdrawalHash, _proofSubmitter)
+
+	if err != nil {
+		return err
+	}
+
+	return err
+
+}
+
+// CheckWithdrawal is a free data retrieval call binding the contract method 0x71c1566e.
+//
+// Solidity: function checkWithdrawal(bytes32 _withdrawalHash, address _proofSubmitter) view returns()
+func (_OptimismPortal *OptimismPortalSession) CheckWithdrawal(_withdrawalHash [32]byte, _proofSubmitter common.Address) error {
+	return _OptimismPortal.Contract.CheckWithdrawal(&_OptimismPortal.CallOpts, _withdrawalHash, _proofSubmitter)
+}
+
+// CheckWithdrawal is a free data retrieval call binding the contract method 0x71c1566e.
+//
+// Solidity: function checkWithdrawal(bytes32 _withdrawalHash, address _proofSubmitter) view returns()
+func (_OptimismPortal *OptimismPortalCallerSession) CheckWithdrawal(_withdrawalHash [32]byte, _proofSubmitter common.Address) error {
+	return _OptimismPortal.Contract.CheckWithdrawal(&_OptimismPortal.CallOpts, _withdrawalHash, _proofSubmitter)
+}
+
+// DisputeGameBlacklist is a free data retrieval call binding the contract method 0x45884d32.
+//
+// Solidity: function disputeGameBlacklist(address ) view returns(bool)
+func (_OptimismPortal *OptimismPortalCaller) DisputeGameBlacklist(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out []interface{}
+	err := _OptimismPortal.contract.Call(opts, &out, "disputeGameBlacklist", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DisputeGameBlacklist is a free data retrieval call binding the contract method 0x45884d32.
+//
+// Solidity: function disputeGameBlacklist(address ) view returns(bool)
+func (_OptimismPortal *OptimismPortalSession) DisputeGameBlacklist(arg0 common.Address) (bool, error) {
+	return _OptimismPortal.Contract.DisputeGameBlacklist(&_OptimismPortal.CallOpts, arg0)
+}
+
+// DisputeGameBlacklist is a free data retrieval call binding the contract method 0x45884d32.
+//
+// Solidity: function disputeGameBlacklist(address ) view returns(bool)
+func (_OptimismPortal *OptimismPortalCallerSession) DisputeGameBlacklist(arg0 common.Address) (bool, error) {
+	return _OptimismPortal.Contract.DisputeGameBlacklist(&_OptimismPortal.CallOpts, arg0)
+}
+
+// DisputeGameFactory is a free data retrieval call binding the contract method 0xf2b4e617.
+//
+// Solidity: function disputeGameFactory() view returns(address)
+func (_OptimismPortal *OptimismPortalCaller) DisputeGameFactory(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _OptimismPortal.contract.Call(opts, &out, "disputeGameFactory")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// DisputeGameFactory is a free data retrieval call binding the contract method 0xf2b4e617.
+//
+// Solidity: function disputeGameFactory() view returns(address)
+func (_OptimismPortal *OptimismPortalSession) DisputeGameFactory() (common.Address, error) {
+	return _OptimismPortal.Contract.DisputeGameFactory(&_OptimismPortal.CallOpts)
+}
+
+// DisputeGameFactory is a free data retrieval call binding the contract method 0xf2b4e617.
+//
+// Solidity: function disputeGameFactory() view returns(address)
+func (_OptimismPortal *OptimismPortalCallerSession) DisputeGameFactory() (common.Address, error) {
+	return _OptimismPortal.Contract.DisputeGameFactory(&_OptimismPortal.CallOpts)
+}
+
+// DisputeGameFinalityDelaySeconds is a free data retrieval call binding the contract method 0x952b2797.
+//
+// Solidity: function disputeGameFinalityDelaySeconds() view returns(uint256)
+func (_OptimismPortal *OptimismPortalCaller) DisputeGameFinalityDelaySeconds(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _OptimismPortal.contract.Call(opts, &out, "disputeGameFinalityDelaySeconds")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DisputeGameFinalityDelaySeconds is a free data retrieval call binding the contract method 0x952b2797.
+//
+// Solidity: function disputeGameFinalityDelaySeconds() view returns(uint256)
+func (_OptimismPortal *OptimismPortalSession) DisputeGameFinalityDelaySeconds() (*big.Int, error) {
+	return _OptimismPortal.Contract.DisputeGameFinalityDelaySeconds(&_OptimismPortal.CallOpts)
+}
+
+// DisputeGameFinalityDelaySeconds is a free data retrieval call binding the contract method 0x952b2797.
+//
+// Solidity: function disputeGameFinalityDelaySeconds() view returns(uint256)
+func (_OptimismPortal *OptimismPortalCallerSession) DisputeGameFinalityDelaySeconds() (*big.Int, error) {
+	return _OptimismPortal.Contract.DisputeGameFinalityDelaySeconds(&_OptimismPortal.CallOpts)
+}
+
+// FinalizedWithdrawals is a free data retrieval call binding the contract method 0xa14238e7.
+//
+// Solidity: function finalizedWithdrawals(bytes32 ) view returns(bool)
+func (_OptimismPortal *OptimismPortalCaller) FinalizedWithdrawals(opts *bind.CallOpts, arg0 [32]byte) (bool, error) {
+	var out []interface{}
+	err := _OptimismPortal.contract.Call(opts, &out, "finalizedWithdrawals", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// FinalizedWithdrawals is a free data retrieval call binding the contract method 0xa14238e7.
+//
+// Solidity: function finalizedWithdrawals(bytes32 ) view returns(bool)
+func (_OptimismPortal *OptimismPortalSession) FinalizedWithdrawals(arg0 [32]byte) (bool, error) {
+	return _OptimismPortal.Contract.FinalizedWithdrawals(&_OptimismPortal.CallOpts, arg0)
+}
+
+// FinalizedWithdrawals is a free data retrieval call binding the contract method 0xa14238e7.
+//
+// Solidity: function finalizedWithdrawals(bytes32 ) view returns(bool)
+func (_OptimismPortal *OptimismPortalCallerSession) FinalizedWithdrawals(arg0 [32]byte) (bool, error) {
+	return _OptimismPortal.Contract.FinalizedWithdrawals(&_OptimismPortal.CallOpts, arg0)
+}
+
+// L2Sender is a free data retrieval call binding the contract method 0x9bf62d82.
+//
+// Solidity: function l2Sender() view returns(address)
+func (_OptimismPortal *OptimismPortalCaller) L2Sender(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _OptimismPortal.contract.Call(opts, &out, "l2Sender")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// L2Sender is a free data retrieval call binding the contract method 0x9bf62d82.
+//
+// Solidity: function l2Sender() view returns(address)
+func (_OptimismPortal *OptimismPortalSession) L2Sender() (common.Address, error) {
+	return _OptimismPortal.Contract.L2Sender(&_OptimismPortal.CallOpts)
+}
+
+// L2Sender is a free data retrieval call binding the contract method 0x9bf62d82.
+//
+// Solidity: function l2Sender() view returns(address)
+func (_OptimismPortal *OptimismPortalCallerSession) L2Sender() (common.Address, error) {
+	return _OptimismPortal.Contract.L2Sender(&_OptimismPortal.CallOpts)
+}
+
+// NumProofSubmitters is a free data retrieval call binding the contract method 0x513747ab.
+//
+// Solidity: function numProofSubmitters(bytes32 _withdrawalHash) view returns(uint256)
+func (_OptimismPortal *OptimismPortalCaller) NumProofSubmitters(opts *bind.CallOpts, _withdrawalHash [32]byte) (*big.Int, error) {
+	var out []interface{}
+	err := _OptimismPortal.contract.Call(opts, &out, "numProofSubmitters", _withdrawalHash)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// NumProofSubmitters is a free data retrieval call binding the contract method 0x513747ab.
+//
+// Solidity: function numProofSubmitters(bytes32 _withdrawalHash) view returns(uint256)
+func (_OptimismPortal *OptimismPortalSession) NumProofSubmitters(_withdrawalHash [32]byte) (*big.Int, error) {
+	return _OptimismPortal.Contract.NumProofSubmitters(&_OptimismPortal.CallOpts, _withdrawalHash)
+}
+
+// NumProofSubmitters is a free data retrieval call binding the contract method 0x513747ab.
+//
+// Solidity: function numProofSubmitters(bytes32 _withdrawalHash) view returns(uint256)
+func (_OptimismPortal *OptimismPortalCallerSession) NumProofSubmitters(_withdrawalHash [32]byte) (*big.Int, error) {
+	return _OptimismPortal.Contract.NumProofSubmitters(&_OptimismPortal.CallOpts, _withdrawalHash)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_OptimismPortal *OptimismPortalCaller) Paused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _OptimismPortal.contract.Call(opts, &out, "paused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_OptimismPortal *OptimismPortalSession) Paused() (bool, error) {
+	return _OptimismPortal.Contract.Paused(&_OptimismPortal.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_OptimismPortal *OptimismPortalCallerSession) Paused() (bool, error) {
+	return _OptimismPortal.Contract.Paused(&_OptimismPortal.CallOpts)
+}
+
+// ProofMaturityDelaySeconds is a free data retrieval call binding the contract method 0xbf653a5c.
+//
+// Solidity: function proofMaturityDelaySeconds() view returns(uint256)
+func (_OptimismPortal *OptimismPortalCaller) ProofMaturityDelaySeconds(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _OptimismPortal.contract.Call(opts, &out, "proofMaturityDelaySeconds")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ProofMaturityDelaySeconds is a free data retrieval call binding the contract method 0xbf653a5c.
+//
+// Solidity: function proofMaturityDelaySeconds() view returns(uint256)
+func (_OptimismPortal *OptimismPortalSession) ProofMaturityDelaySeconds() (*big.Int, error) {
+	return _OptimismPortal.Contract.ProofMaturityDelaySeconds(&_OptimismPortal.CallOpts)
+}
+
+// ProofMaturityDelaySeconds is a free data retrieval call binding the contract method 0xbf653a5c.
+//
+// Solidity: function proofMaturityDelaySeconds() view returns(uint256)
+func (_OptimismPortal *OptimismPortalCallerSession) ProofMaturityDelaySeconds() (*big.Int, error) {
+	return _OptimismPortal.Contract.ProofMaturityDelaySeconds(&_OptimismPortal.CallOpts)
+}
+
+// ProofSubmitters is a free data retrieval call binding the contract method 0xa3860f48.
+//
+// Solidity: function proofSubmitters(bytes32 , uint256 ) view returns(address)
+func (_OptimismPortal *OptimismPortalCaller) ProofSubmitters(opts *bind.CallOpts, arg0 [32]byte, arg1 *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _OptimismPortal.contract.Call(opts, &out, "proofSubmitters", arg0, arg1)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// ProofSubmitters is a free data retrieval call binding the contract method 0xa3860f48.
+//
+// Solidity: function proofSubmitters(bytes32 , uint256 ) view returns(address)
+func (_OptimismPortal *OptimismPortalSession) ProofSubmitters(arg0 [32]byte, arg1 *big.Int) (common.Address, error) {
+	return _OptimismPortal.Contract.ProofSubmitters(&_OptimismPortal.CallOpts, arg0, arg1)
+}
+
+// ProofSubmitters is a free data retrieval call binding the contract method 0xa3860f48.
+//
+// Solidity: function proofSubmitters(bytes32 , uint256 ) view returns(address)
+func (_OptimismPortal *OptimismPortalCallerSession) ProofSubmitters(arg0 [32]byte, arg1 *big.Int) (common.Address, error) {
+	return _OptimismPortal.Contract.ProofSubmitters(&_OptimismPortal.CallOpts, arg0, arg1)
+}
+
+// ProvenWithdrawals is a free data retrieval call binding the contract method 0xbb2c727e.
+//
+// Solidity: function provenWithdrawals(bytes32 , address ) view returns(address disputeGameProxy, uint64 timestamp)
+func (_OptimismPortal *OptimismPortalCaller) ProvenWithdrawals(opts *bind.CallOpts, arg0 [32]byte, arg1 common.Address) (struct {
+	DisputeGameProxy common.Address
+	Timestamp        uint64
+}, error) {
+	var out []interface{}
+	err := _OptimismPortal.contract.Call(opts, &out, "provenWithdrawals", arg0, arg1)
+
+	outstruct := new(struct {
+		DisputeGameProxy common.Address
+		Timestamp        uint64
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.DisputeGameProxy = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.Timestamp = *abi.ConvertType(out[1], new(uint64)).(*uint64)
+
+	return *outstruct, err
+
+}
+
+// ProvenWithdrawals is a free data retrieval call binding the contract method 0xbb2c727e.
+//
+// Solidity: function provenWithdrawals(bytes32 , address ) view returns(address disputeGameProxy, uint64 timestamp)
+func (_OptimismPortal *OptimismPortalSession) ProvenWithdrawals(arg0 [32]byte, arg1 common.Address) (struct {
+	DisputeGameProxy common.Address
+	Timestamp        uint64
+}, error) {
+	return _OptimismPortal.Contract.ProvenWithdrawals(&_OptimismPortal.CallOpts, arg0, arg1)
+}
+
+// ProvenWithdrawals is a free data retrieval call binding the contract method 0xbb2c727e.
+//
+// Solidity: function provenWithdrawals(bytes32 , address ) view returns(address disputeGameProxy, uint64 timestamp)
+func (_OptimismPortal *OptimismPortalCallerSession) ProvenWithdrawals(arg0 [32]byte, arg1 common.Address) (struct {
+	DisputeGameProxy common.Address
+	Timestamp        uint64
+}, error) {
+	return _OptimismPortal.Contract.ProvenWithdrawals(&_OptimismPortal.CallOpts, arg0, arg1)
+}
+
+// RespectedGameType is a free data retrieval call binding the contract method 0x3c9f397c.
+//
+// Solidity: function respectedGameType() view returns(uint32)
+func (_OptimismPortal *OptimismPortalCaller) RespectedGameType(opts *bind.CallOpts) (uint32, error) {
+	var out []interface{}
+	err := _OptimismPortal.contract.Call(opts, &out, "respectedGameType")
+
+	if err != nil {
+		return *new(uint32), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint32)).(*uint32)
+
+	return out0, err
+
+}
+
+// RespectedGameType is a free data retrieval call binding the contract method 0x3c9f397c.
+//
+// Solidity: function respectedGameType() view returns(uint32)
+func (_OptimismPortal *OptimismPortalSession) RespectedGameType() (uint32, error) {
+	return _OptimismPortal.Contract.RespectedGameType(&_OptimismPortal.CallOpts)
+}
+
+// RespectedGameType is a free data retrieval call binding the contract method 0x3c9f397c.
+//
+// Solidity: function respectedGameType() view returns(uint32)
+func (_OptimismPortal *OptimismPortalCallerSession) RespectedGameType() (uint32, error) {
+	return _OptimismPortal.Contract.RespectedGameType(&_OptimismPortal.CallOpts)
+}
+
+// RespectedGameTypeUpdatedAt is a free data retrieval call binding the contract method 0x4fd0434c.
+//
+// Solidity: function respectedGameTypeUpdatedAt() view returns(uint64)
+func (_OptimismPortal *OptimismPortalCaller) RespectedGameTypeUpdatedAt(opts *bind.CallOpts) (uint64, error) {
+	var out []interface{}
+	err := _OptimismPortal.contract.Call(opts, &out, "respectedGameTypeUpdatedAt")
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+// RespectedGameTypeUpdatedAt is a free data retrieval call binding the contract method 0x4fd0434c.
+//
+// Solidity: function respectedGameTypeUpdatedAt() view returns(uint64)
+func (_OptimismPortal *OptimismPortalSession) RespectedGameTypeUpdatedAt() (uint64, error) {
+	return _OptimismPortal.Contract.RespectedGameTypeUpdatedAt(&_OptimismPortal.CallOpts)
+}
+
+// RespectedGameTypeUpdatedAt is a free data retrieval call binding the contract method 0x4fd0434c.
+//
+// Solidity: function respectedGameTypeUpdatedAt() view returns(uint64)
+func (_OptimismPortal *OptimismPortalCallerSession) RespectedGameTypeUpdatedAt() (uint64, error) {
+	return _OptimismPortal.Contract.RespectedGameTypeUpdatedAt(&_OptimismPortal.CallOpts)
+}
+
+// SystemConfig is a free data retrieval call binding the contract method 0x33d7e2bd.
+//
+// Solidity: function systemConfig() view returns(address)
+func (_OptimismPortal *OptimismPortalCaller) SystemConfig(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _OptimismPortal.contract.Call(opts, &out, "systemConfig")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SystemConfig is a free data retrieval call binding the contract method 0x33d7e2bd.
+//
+// Solidity: function systemConfig() view returns(address)
+func (_OptimismPortal *OptimismPortalSession) SystemConfig() (common.Address, error) {
+	return _OptimismPortal.Contract.SystemConfig(&_OptimismPortal.CallOpts)
+}
+
+// SystemConfig is a free data retrieval call binding the contract method 0x33d7e2bd.
+//
+// Solidity: function systemConfig() view returns(address)
+func (_OptimismPortal *OptimismPortalCallerSession) SystemConfig() (common.Address, error) {
+	return _OptimismPortal.Contract.SystemConfig(&_OptimismPortal.CallOpts)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() view returns(string)
+func (_OptimismPortal *OptimismPortalCaller) Version(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _OptimismPortal.contract.Call(opts, &out, "version")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() view returns(string)
+func (_OptimismPortal *OptimismPortalSession) Version() (string, error) {
+	return _OptimismPortal.Contract.Version(&_OptimismPortal.CallOpts)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() view returns(string)
+func (_OptimismPortal *OptimismPortalCallerSession) Version() (string, error) {
+	return _OptimismPortal.Contract.Version(&_OptimismPortal.CallOpts)
+}
+
+// DepositTransaction is a paid mutator transaction binding the contract method 0xe9e05c42.
+//
+// Solidity: function depositTransaction(address _to, uint256 _value, uint64 _gasLimit, bool _isCreation, bytes _data) payable returns()
+func (_OptimismPortal *OptimismPortalTransactor) DepositTransaction(opts *bind.TransactOpts, _to common.Address, _value *big.Int, _gasLimit uint64, _isCreation bool, _data []byte) (*types.Transaction, error) {
+	return _OptimismPortal.contract.Transact(opts, "depositTransaction", _to, _value, _gasLimit, _isCreation, _data)
+}
+
+// DepositTransaction is a paid mutator transaction binding the contract method 0xe9e05c42.
+//
+// Solidity: function depositTransaction(address _to, uint256 _value, uint64 _gasLimit, bool _isCreation, bytes _data) payable returns()
+func (_OptimismPortal *OptimismPortalSession) DepositTransaction(_to common.Address, _value *big.Int, _gasLimit uint64, _isCreation bool, _data []byte) (*types.Transaction, error) {
+	return _OptimismPortal.Contract.DepositTransaction(&_OptimismPortal.TransactOpts, _to, _value, _gasLimit, _isCreation, _data)
+}
+
+// DepositTransaction is a paid mutator transaction binding the contract method 0xe9e05c42.
+//
+// Solidity: function depositTransaction(address _to, uint256 _value, uint64 _gasLimit, bool _isCreation, bytes _data) payable returns()
+func (_OptimismPortal *OptimismPortalTransactorSession) DepositTransaction(_to common.Address, _value *big.Int, _gasLimit uint64, _isCreation bool, _data []byte) (*types.Transaction, error) {
+	return _OptimismPortal.Contract.DepositTransaction(&_OptimismPortal.TransactOpts, _to, _value, _gasLimit, _isCreation, _data)
+}
+
+// FinalizeWithdrawalTransaction is a paid mutator transaction binding the contract method 0x8c3152e9.
+//
+// Solidity: function finalizeWithdrawalTransaction((uint256,address,address,uint256,uint256,bytes) _tx) returns()
+func (_OptimismPortal *OptimismPortalTransactor) FinalizeWithdrawalTransaction(opts *bind.TransactOpts, _tx TypesWithdrawalTransaction) (*types.Transaction, error) {
+	return _OptimismPortal.contract.Transact(opts, "finalizeWithdrawalTransaction", _tx)
+}
+
+// FinalizeWithdrawalTransaction is a paid mutator transaction binding the contract method 0x8c3152e9.
+//
+// Solidity: function finalizeWithdrawalTransaction((uint256,address,address,uint256,uint256,bytes) _tx) returns()
+func (_OptimismPortal *OptimismPortalSession) FinalizeWithdrawalTransaction(_tx TypesWithdrawalTransaction) (*types.Transaction, error) {
+	return _OptimismPortal.Contract.FinalizeWithdrawalTransaction(&_OptimismPortal.TransactOpts, _tx)
+}
+
+// FinalizeWithdrawalTransaction is a paid mutator transaction binding the contract method 0x8c3152e9.
+//
+// Solidity: function finalizeWithdrawalTransaction((uint256,address,address,uint256,uint256,bytes) _tx) returns()
+func (_OptimismPortal *OptimismPortalTransactorSession) FinalizeWithdrawalTransaction(_tx TypesWithdrawalTransaction) (*types.Transaction, error) {
+	return _OptimismPortal.Contract.FinalizeWithdrawalTransaction(&_OptimismPortal.TransactOpts, _tx)
+}
+
+// FinalizeWithdrawalTransactionExternalProof is a paid mutator transaction binding the contract method 0x43ca1c50.
+//
+// Solidity: function finalizeWithdrawalTransactionExternalProof((uint256,address,address,uint256,uint256,bytes) _tx, address _proofSubmitter) returns()
+func (_OptimismPortal *OptimismPortalTransactor) FinalizeWithdrawalTransactionExternalProof(opts *bind.TransactOpts, _tx TypesWithdrawalTransaction, _proofSubmitter common.Address) (*types.Transaction, error) {
+	return _OptimismPortal.contract.Transact(opts, "finalizeWithdrawalTransactionExternalProof", _tx, _proofSubmitter)
+}
+
+// FinalizeWithdrawalTransactionExternalProof is a paid mutator transaction binding the contract method 0x43ca1c50.
+//
+// Solidity: function finalizeWithdrawalTransactionExternalProof((uint256,address,address,uint256,uint256,bytes) _tx, address _proofSubmitter) returns()
+func (_OptimismPortal *OptimismPortalSession) FinalizeWithdrawalTransactionExternalProof(_tx TypesWithdrawalTransaction, _proofSubmitter common.Address) (*types.Transaction, error) {
+	return _OptimismPortal.Contract.FinalizeWithdrawalTransactionExternalProof(&_OptimismPortal.TransactOpts, _tx, _proofSubmitter)
+}
+
+// FinalizeWithdrawalTransactionExternalProof is a paid mutator transaction binding the contract method 0x43ca1c50.
+//
+// Solidity: function finalizeWithdrawalTransactionExternalProof((uint256,address,address,uint256,uint256,bytes) _tx, address _proofSubmitter) returns()
+func (_OptimismPortal *OptimismPortalTransactorSession) FinalizeWithdrawalTransactionExternalProof(_tx TypesWithdrawalTransaction, _proofSubmitter common.Address) (*types.Transaction, error) {
+	return _OptimismPortal.Contract.FinalizeWithdrawalTransactionExternalProof(&_OptimismPortal.TransactOpts, _tx, _proofSubmitter)
+}
+
+// ProveWithdrawalTransaction is a paid mutator transaction binding the contract method 0x4870496f.
+//
+// Solidity: function proveWithdrawalTransaction((uint256,address,address,uint256,uint256,bytes) _tx, uint256 _disputeGameIndex, (bytes32,bytes32,bytes32,bytes32) _outputRootProof, bytes[] _withdrawalProof) returns()
+func (_OptimismPortal *OptimismPortalTransactor) ProveWithdrawalTransaction(opts *bind.TransactOpts, _tx TypesWithdrawalTransaction, _disputeGameIndex *big.Int, _outputRootProof TypesOutputRootProof, _withdrawalProof [][]byte) (*types.Transaction, error) {
+	return _OptimismPortal.contract.Transact(opts, "proveWithdrawalTransaction", _tx, _disputeGameIndex, _outputRootProof, _withdrawalProof)
+}
+
+// ProveWithdrawalTransaction is a paid mutator transaction binding the contract method 0x4870496f.
+//
+// Solidity: function proveWithdrawalTransaction((uint256,address,address,uint256,uint256,bytes) _tx, uint256 _disputeGameIndex, (bytes32,bytes32,bytes32,bytes32) _outputRootProof, bytes[] _withdrawalProof) returns()
+func (_OptimismPortal *OptimismPortalSession) ProveWithdrawalTransaction(_tx TypesWithdrawalTransaction, _disputeGameIndex *big.Int, _outputRootProof TypesOutputRootProof, _withdrawalProof [][]byte) (*types.Transaction, error) {
+	return _OptimismPortal.Contract.ProveWithdrawalTransaction(&_OptimismPortal.TransactOpts, _tx, _disputeGameIndex, _outputRootProof, _withdrawalProof)
+}
+
+// ProveWithdrawalTransaction is a paid mutator transaction binding the contract method 0x4870496f.
+//
+// Solidity: function proveWithdrawalTransaction((uint256,address,address,uint256,uint256,bytes) _tx, uint256 _disputeGameIndex, (bytes32,bytes32,bytes32,bytes32) _outputRootProof, bytes[] _withdrawalProof) returns()
+func (_OptimismPortal *OptimismPortalTransactorSession) ProveWithdrawalTransaction(_tx TypesWithdrawalTransaction, _disputeGameIndex *big.Int, _outputRootProof TypesOutputRootProof, _withdrawalProof [][]byte) (*types.Transaction, error) {
+	return _OptimismPortal.Contract.ProveWithdrawalTransaction(&_OptimismPortal.TransactOpts, _tx, _disputeGameIndex, _outputRootProof, _withdrawalProof)
+}
+
+// OptimismPortalTransactionDepositedIterator is returned from FilterTransactionDeposited and is used to iterate over the raw logs and unpacked data for TransactionDeposited events raised by the OptimismPortal contract.
+type OptimismPortalTransactionDepositedIterator struct {
+	Event *OptimismPortalTransactionDeposited // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OptimismPortalTransactionDepositedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OptimismPortalTransactionDeposited)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OptimismPortalTransactionDeposited)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OptimismPortalTransactionDepositedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OptimismPortalTransactionDepositedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OptimismPortalTransactionDeposited represents a TransactionDeposited event raised by the OptimismPortal contract.
+type OptimismPortalTransactionDeposited struct {
+	From       common.Address
+	To         common.Address
+	Version    *big.Int
+	OpaqueData []byte
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransactionDeposited is a free log retrieval operation binding the contract event 0xb3813568d9991fc951961fcb4c784893574240a28925604d09fc577c55bb7c32.
+//
+// Solidity: event TransactionDeposited(address indexed from, address indexed to, uint256 indexed version, bytes opaqueData)
+func (_OptimismPortal *OptimismPortalFilterer) FilterTransactionDeposited(opts *bind.FilterOpts, from []common.Address, to []common.Address, version []*big.Int) (*OptimismPortalTransactionDepositedIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var versionRule []interface{}
+	for _, versionItem := range version {
+		versionRule = append(versionRule, versionItem)
+	}
+
+	logs, sub, err := _OptimismPortal.contract.FilterLogs(opts, "TransactionDeposited", fromRule, toRule, versionRule)
+	if err != nil {
+		return nil, err
+	}
+	return &OptimismPortalTransactionDepositedIterator{contract: _OptimismPortal.contract, event: "TransactionDeposited", logs: logs, sub: sub}, nil
+}
+
+// WatchTransactionDeposited is a free log subscription operation binding the contract event 0xb3813568d9991fc951961fcb4c784893574240a28925604d09fc577c55bb7c32.
+//
+// Solidity: event TransactionDeposited(address indexed from, address indexed to, uint256 indexed version, bytes opaqueData)
+func (_OptimismPortal *OptimismPortalFilterer) WatchTransactionDeposited(opts *bind.WatchOpts, sink chan<- *OptimismPortalTransactionDeposited, from []common.Address, to []common.Address, version []*big.Int) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var versionRule []interface{}
+	for _, versionItem := range version {
+		versionRule = append(versionRule, versionItem)
+	}
+
+	logs, sub, err := _OptimismPortal.contract.WatchLogs(opts, "TransactionDeposited", fromRule, toRule, versionRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OptimismPortalTransactionDeposited)
+				if err := _OptimismPortal.contract.UnpackLog(event, "TransactionDeposited", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransactionDeposited is a log parse operation binding the contract event 0xb3813568d9991fc951961fcb4c784893574240a28925604d09fc577c55bb7c32.
+//
+// Solidity: event TransactionDeposited(address indexed from, address indexed to, uint256 indexed version, bytes opaqueData)
+func (_OptimismPortal *OptimismPortalFilterer) ParseTransactionDeposited(log types.Log) (*OptimismPortalTransactionDeposited, error) {
+	event := new(OptimismPortalTransactionDeposited)
+	if err := _OptimismPortal.contract.UnpackLog(event, "TransactionDeposited", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OptimismPortalWithdrawalFinalizedIterator is returned from FilterWithdrawalFinalized and is used to iterate over the raw logs and unpacked data for WithdrawalFinalized events raised by the OptimismPortal contract.
+type OptimismPortalWithdrawalFinalizedIterator struct {
+	Event *OptimismPortalWithdrawalFinalized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OptimismPortalWithdrawalFinalizedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OptimismPortalWithdrawalFinalized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OptimismPortalWithdrawalFinalized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OptimismPortalWithdrawalFinalizedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OptimismPortalWithdrawalFinalizedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OptimismPortalWithdrawalFinalized represents a WithdrawalFinalized event raised by the OptimismPortal contract.
+type OptimismPortalWithdrawalFinalized struct {
+	WithdrawalHash [32]byte
+	Success        bool
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterWithdrawalFinalized is a free log retrieval operation binding the contract event 0xdb5c7652857aa163daadd670e116628fb42e869d8ac4251ef8971d9e5727df1b.
+//
+// Solidity: event WithdrawalFinalized(bytes32 indexed withdrawalHash, bool success)
+func (_OptimismPortal *OptimismPortalFilterer) FilterWithdrawalFinalized(opts *bind.FilterOpts, withdrawalHash [][32]byte) (*OptimismPortalWithdrawalFinalizedIterator, error) {
+
+	var withdrawalHashRule []interface{}
+	for _, withdrawalHashItem := range withdrawalHash {
+		withdrawalHashRule = append(withdrawalHashRule, withdrawalHashItem)
+	}
+
+	logs, sub, err := _OptimismPortal.contract.FilterLogs(opts, "WithdrawalFinalized", withdrawalHashRule)
+	if err != nil {
+		return nil, err
+	}
+	return &OptimismPortalWithdrawalFinalizedIterator{contract: _OptimismPortal.contract, event: "WithdrawalFinalized", logs: logs, sub: sub}, nil
+}
+
+// WatchWithdrawalFinalized is a free log subscription operation binding the contract event 0xdb5c7652857aa163daadd670e116628fb42e869d8ac4251ef8971d9e5727df1b.
+//
+// Solidity: event WithdrawalFinalized(bytes32 indexed withdrawalHash, bool success)
+func (_OptimismPortal *OptimismPortalFilterer) WatchWithdrawalFinalized(opts *bind.WatchOpts, sink chan<- *OptimismPortalWithdrawalFinalized, withdrawalHash [][32]byte) (event.Subscription, error) {
+
+	var withdrawalHashRule []interface{}
+	for _, withdrawalHashItem := range withdrawalHash {
+		withdrawalHashRule = append(withdrawalHashRule, withdrawalHashItem)
+	}
+
+	logs, sub, err := _OptimismPortal.contract.WatchLogs(opts, "WithdrawalFinalized", withdrawalHashRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OptimismPortalWithdrawalFinalized)
+				if err := _OptimismPortal.contract.UnpackLog(event, "WithdrawalFinalized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseWithdrawalFinalized is a log parse operation binding the contract event 0xdb5c7652857aa163daadd670e116628fb42e869d8ac4251ef8971d9e5727df1b.
+//
+// Solidity: event WithdrawalFinalized(bytes32 indexed withdrawalHash, bool success)
+func (_OptimismPortal *OptimismPortalFilterer) ParseWithdrawalFinalized(log types.Log) (*OptimismPortalWithdrawalFinalized, error) {
+	event := new(OptimismPortalWithdrawalFinalized)
+	if err := _OptimismPortal.contract.UnpackLog(event, "WithdrawalFinalized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OptimismPortalWithdrawalProvenIterator is returned from FilterWithdrawalProven and is used to iterate over the raw logs and unpacked data for WithdrawalProven events raised by the OptimismPortal contract.
+type OptimismPortalWithdrawalProvenIterator struct {
+	Event *OptimismPortalWithdrawalProven // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OptimismPortalWithdrawalProvenIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OptimismPortalWithdrawalProven)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OptimismPortalWithdrawalProven)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OptimismPortalWithdrawalProvenIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OptimismPortalWithdrawalProvenIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OptimismPortalWithdrawalProven represents a WithdrawalProven event raised by the OptimismPortal contract.
+type OptimismPortalWithdrawalProven struct {
+	WithdrawalHash [32]byte
+	From           common.Address
+	To             common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterWithdrawalProven is a free log retrieval operation binding the contract event 0x67a6208cfcc0801d50f6cbe764733f4fddf66ac0b04442061a8a8c0cb6b63f62.
+//
+// Solidity: event WithdrawalProven(bytes32 indexed withdrawalHash, address indexed from, address indexed to)
+func (_OptimismPortal *OptimismPortalFilterer) FilterWithdrawalProven(opts *bind.FilterOpts, withdrawalHash [][32]byte, from []common.Address, to []common.Address) (*OptimismPortalWithdrawalProvenIterator, error) {
+
+	var withdrawalHashRule []interface{}
+	for _, withdrawalHashItem := range withdrawalHash {
+		withdrawalHashRule = append(withdrawalHashRule, withdrawalHashItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _OptimismPortal.contract.FilterLogs(opts, "WithdrawalProven", withdrawalHashRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &OptimismPortalWithdrawalProvenIterator{contract: _OptimismPortal.contract, event: "WithdrawalProven", logs: logs, sub: sub}, nil
+}
+
+// WatchWithdrawalProven is a free log subscription operation binding the contract event 0x67a6208cfcc0801d50f6cbe764733f4fddf66ac0b04442061a8a8c0cb6b63f62.
+//
+// Solidity: event WithdrawalProven(bytes32 indexed withdrawalHash, address indexed from, address indexed to)
+func (_OptimismPortal *OptimismPortalFilterer) WatchWithdrawalProven(opts *bind.WatchOpts, sink chan<- *OptimismPortalWithdrawalProven, withdrawalHash [][32]byte, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var withdrawalHashRule []interface{}
+	for _, withdrawalHashItem := range withdrawalHash {
+		withdrawalHashRule = append(withdrawalHashRule, withdrawalHashItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _OptimismPortal.contract.WatchLogs(opts, "WithdrawalProven", withdrawalHashRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OptimismPortalWithdrawalProven)
+				if err := _OptimismPortal.contract.UnpackLog(event, "WithdrawalProven", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseWithdrawalProven is a log parse operation binding the contract event 0x67a6208cfcc0801d50f6cbe764733f4fddf66ac0b04442061a8a8c0cb6b63f62.
+//
+// Solidity: event WithdrawalProven(bytes32 indexed withdrawalHash, address indexed from, address indexed to)
+func (_OptimismPortal *OptimismPortalFilterer) ParseWithdrawalProven(log types.Log) (*OptimismPortalWithdrawalProven, error) {
+	event := new(OptimismPortalWithdrawalProven)
+	if err := _OptimismPortal.contract.UnpackLog(event, "WithdrawalProven", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OptimismPortalWithdrawalProvenExtension1Iterator is returned from FilterWithdrawalProvenExtension1 and is used to iterate over the raw logs and unpacked data for WithdrawalProvenExtension1 events raised by the OptimismPortal contract.
+type OptimismPortalWithdrawalProvenExtension1Iterator struct {
+	Event *OptimismPortalWithdrawalProvenExtension1 // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OptimismPortalWithdrawalProvenExtension1Iterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OptimismPortalWithdrawalProvenExtension1)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OptimismPortalWithdrawalProvenExtension1)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OptimismPortalWithdrawalProvenExtension1Iterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OptimismPortalWithdrawalProvenExtension1Iterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OptimismPortalWithdrawalProvenExtension1 represents a WithdrawalProvenExtension1 event raised by the OptimismPortal contract.
+type OptimismPortalWithdrawalProvenExtension1 struct {
+	WithdrawalHash [32]byte
+	ProofSubmitter common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterWithdrawalProvenExtension1 is a free log retrieval operation binding the contract event 0x798f9f13695f8f045aa5f80ed8efebb695f3c7fe65da381969f2f28bf3c60b97.
+//
+// Solidity: event WithdrawalProvenExtension1(bytes32 indexed withdrawalHash, address indexed proofSubmitter)
+func (_OptimismPortal *OptimismPortalFilterer) FilterWithdrawalProvenExtension1(opts *bind.FilterOpts, withdrawalHash [][32]byte, proofSubmitter []common.Address) (*OptimismPortalWithdrawalProvenExtension1Iterator, error) {
+
+	var withdrawalHashRule []interface{}
+	for _, withdrawalHashItem := range withdrawalHash {
+		withdrawalHashRule = append(withdrawalHashRule, withdrawalHashItem)
+	}
+	var proofSubmitterRule []interface{}
+	for _, proofSubmitterItem := range proofSubmitter {
+		proofSubmitterRule = append(proofSubmitterRule, proofSubmitterItem)
+	}
+
+	logs, sub, err := _OptimismPortal.contract.FilterLogs(opts, "WithdrawalProvenExtension1", withdrawalHashRule, proofSubmitterRule)
+	if err != nil {
+		return nil, err
+	}
+	return &OptimismPortalWithdrawalProvenExtension1Iterator{contract: _OptimismPortal.contract, event: "WithdrawalProvenExtension1", logs: logs, sub: sub}, nil
+}
+
+// WatchWithdrawalProvenExtension1 is a free log subscription operation binding the contract event 0x798f9f13695f8f045aa5f80ed8efebb695f3c7fe65da381969f2f28bf3c60b97.
+//
+// Solidity: event WithdrawalProvenExtension1(bytes32 indexed withdrawalHash, address indexed proofSubmitter)
+func (_OptimismPortal *OptimismPortalFilterer) WatchWithdrawalProvenExtension1(opts *bind.WatchOpts, sink chan<- *OptimismPortalWithdrawalProvenExtension1, withdrawalHash [][32]byte, proofSubmitter []common.Address) (event.Subscription, error) {
+
+	var withdrawalHashRule []interface{}
+	for _, withdrawalHashItem := range withdrawalHash {
+		withdrawalHashRule = append(withdrawalHashRule, withdrawalHashItem)
+	}
+	var proofSubmitterRule []interface{}
+	for _, proofSubmitterItem := range proofSubmitter {
+		proofSubmitterRule = append(proofSubmitterRule, proofSubmitterItem)
+	}
+
+	logs, sub, err := _OptimismPortal.contract.WatchLogs(opts, "WithdrawalProvenExtension1", withdrawalHashRule, proofSubmitterRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OptimismPortalWithdrawalProvenExtension1)
+				if err := _OptimismPortal.contract.UnpackLog(event, "WithdrawalProvenExtension1", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseWithdrawalProvenExtension1 is a log parse operation binding the contract event 0x798f9f13695f8f045aa5f80ed8efebb695f3c7fe65da381969f2f28bf3c60b97.
+//
+// Solidity: event WithdrawalProvenExtension1(bytes32 indexed withdrawalHash, address indexed proofSubmitter)
+func (_OptimismPortal *OptimismPortalFilterer) ParseWithdrawalProvenExtension1(log types.Log) (*OptimismPortalWithdrawalProvenExtension1, error) {
+	event := new(OptimismPortalWithdrawalProvenExtension1)
+	if err := _OptimismPortal.contract.UnpackLog(event, "WithdrawalProvenExtension1", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,533 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package TokenMinter
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// TokenMinterMetaData contains all meta data concerning the TokenMinter contract.
+var TokenMinterMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"burnLimitsPerMessage\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"localTokenMessenger\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\"}]},{\"type\":\"function\",\"name\":\"getLocalToken\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"remoteDomain\",\"type\":\"uint32\"},{\"name\":\"remoteToken\",\"type\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\"}]},{\"type\":\"function\",\"name\":\"remoteTokensToLocalTokens\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"\",\"type\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\"}]},{\"type\":\"function\",\"name\":\"paused\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}]},{\"type\":\"function\",\"name\":\"tokenController\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\"}]},{\"type\":\"function\",\"name\":\"setMaxBurnAmountPerMessage\",\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"localToken\",\"type\":\"address\"},{\"name\":\"burnLimitPerMessage\",\"type\":\"uint256\"}],\"outputs\":[]},{\"type\":\"event\",\"name\":\"SetBurnLimitPerMessage\",\"anonymous\":false,\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"indexed\":true},{\"name\":\"burnLimitPerMessage\",\"type\":\"uint256\",\"indexed\":false}]}]",
+}
+
+// TokenMinterABI is the input ABI used to generate the binding from.
+// Deprecated: Use TokenMinterMetaData.ABI instead.
+var TokenMinterABI = TokenMinterMetaData.ABI
+
+// TokenMinter is an auto generated Go binding around an Ethereum contract.
+type TokenMinter struct {
+	TokenMinterCaller     // Read-only binding to the contract
+	TokenMinterTransactor // Write-only binding to the contract
+	TokenMinterFilterer   // Log filterer for contract events
+}
+
+// TokenMinterCaller is an auto generated read-only Go binding around an Ethereum contract.
+type TokenMinterCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TokenMinterTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type TokenMinterTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TokenMinterFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type TokenMinterFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TokenMinterSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type TokenMinterSession struct {
+	Contract     *TokenMinter      // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// TokenMinterCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type TokenMinterCallerSession struct {
+	Contract *TokenMinterCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts      // Call options to use throughout this session
+}
+
+// TokenMinterTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type TokenMinterTransactorSession struct {
+	Contract     *TokenMinterTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts      // Transaction auth options to use throughout this session
+}
+
+// TokenMinterRaw is an auto generated low-level Go binding around an Ethereum contract.
+type TokenMinterRaw struct {
+	Contract *TokenMinter // Generic contract binding to access the raw methods on
+}
+
+// TokenMinterCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type TokenMinterCallerRaw struct {
+	Contract *TokenMinterCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// TokenMinterTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type TokenMinterTransactorRaw struct {
+	Contract *TokenMinterTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewTokenMinter creates a new instance of TokenMinter, bound to a specific deployed contract.
+func NewTokenMinter(address common.Address, backend bind.ContractBackend) (*TokenMinter, error) {
+	contract, err := bindTokenMinter(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenMinter{TokenMinterCaller: TokenMinterCaller{contract: contract}, TokenMinterTransactor: TokenMinterTransactor{contract: contract}, TokenMinterFilterer: TokenMinterFilterer{contract: contract}}, nil
+}
+
+// NewTokenMinterCaller creates a new read-only instance of TokenMinter, bound to a specific deployed contract.
+func NewTokenMinterCaller(address common.Address, caller bind.ContractCaller) (*TokenMinterCaller, error) {
+	contract, err := bindTokenMinter(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenMinterCaller{contract: contract}, nil
+}
+
+// NewTokenMinterTransactor creates a new write-only instance of TokenMinter, bound to a specific deployed contract.
+func NewTokenMinterTransactor(address common.Address, transactor bind.ContractTransactor) (*TokenMinterTransactor, error) {
+	contract, err := bindTokenMinter(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenMinterTransactor{contract: contract}, nil
+}
+
+// NewTokenMinterFilterer creates a new log filterer instance of TokenMinter, bound to a specific deployed contract.
+func NewTokenMinterFilterer(address common.Address, filterer bind.ContractFilterer) (*TokenMinterFilterer, error) {
+	contract, err := bindTokenMinter(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenMinterFilterer{contract: contract}, nil
+}
+
+// bindTokenMinter binds a generic wrapper to an already deployed contract.
+func bindTokenMinter(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := TokenMinterMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_TokenMinter *TokenMinterRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _TokenMinter.Contract.TokenMinterCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_TokenMinter *TokenMinterRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _TokenMinter.Contract.TokenMinterTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_TokenMinter *TokenMinterRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _TokenMinter.Contract.TokenMinterTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_TokenMinter *TokenMinterCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _TokenMinter.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_TokenMinter *TokenMinterTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _TokenMinter.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_TokenMinter *TokenMinterTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _TokenMinter.Contract.contract.Transact(opts, method, params...)
+}
+
+// BurnLimitsPerMessage is a free data retrieval call binding the contract method 0xa56ec632.
+//
+// Solidity: function burnLimitsPerMessage(address ) view returns(uint256)
+func (_TokenMinter *TokenMinterCaller) BurnLimitsPerMessage(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _TokenMinter.contract.Call(opts, &out, "burnLimitsPerMessage", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BurnLimitsPerMessage is a free data retrieval call binding the contract method 0xa56ec632.
+//
+// Solidity: function burnLimitsPerMessage(address ) view returns(uint256)
+func (_TokenMinter *TokenMinterSession) BurnLimitsPerMessage(arg0 common.Address) (*big.Int, error) {
+	return _TokenMinter.Contract.BurnLimitsPerMessage(&_TokenMinter.CallOpts, arg0)
+}
+
+// BurnLimitsPerMessage is a free data retrieval call binding the contract method 0xa56ec632.
+//
+// Solidity: function burnLimitsPerMessage(address ) view returns(uint256)
+func (_TokenMinter *TokenMinterCallerSession) BurnLimitsPerMessage(arg0 common.Address) (*big.Int, error) {
+	return _TokenMinter.Contract.BurnLimitsPerMessage(&_TokenMinter.CallOpts, arg0)
+}
+
+// GetLocalToken is a free data retrieval call binding the contract method 0x78a0565e.
+//
+// Solidity: function getLocalToken(uint32 remoteDomain, bytes32 remoteToken) view returns(address)
+func (_TokenMinter *TokenMinterCaller) GetLocalToken(opts *bind.CallOpts, remoteDomain uint32, remoteToken [32]byte) (common.Address, error) {
+	var out []interface{}
+	err := _TokenMinter.contract.Call(opts, &out, "getLocalToken", remoteDomain, remoteToken)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetLocalToken is a free data retrieval call binding the contract method 0x78a0565e.
+//
+// Solidity: function getLocalToken(uint32 remoteDomain, bytes32 remoteToken) view returns(address)
+func (_TokenMinter *TokenMinterSession) GetLocalToken(remoteDomain uint32, remoteToken [32]byte) (common.Address, error) {
+	return _TokenMinter.Contract.GetLocalToken(&_TokenMinter.CallOpts, remoteDomain, remoteToken)
+}
+
+// GetLocalToken is a free data retrieval call binding the contract method 0x78a0565e.
+//
+// Solidity: function getLocalToken(uint32 remoteDomain, bytes32 remoteToken) view returns(address)
+func (_TokenMinter *TokenMinterCallerSession) GetLocalToken(remoteDomain uint32, remoteToken [32]byte) (common.Address, error) {
+	return _TokenMinter.Contract.GetLocalToken(&_TokenMinter.CallOpts, remoteDomain, remoteToken)
+}
+
+// LocalTokenMessenger is a free data retrieval call binding the contract method 0x770fc1f0.
+//
+// Solidity: function localTokenMessenger() view returns(address)
+func (_TokenMinter *TokenMinterCaller) LocalTokenMessenger(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _TokenMinter.contract.Call(opts, &out, "localTokenMessenger")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LocalTokenMessenger is a free data retrieval call binding the contract method 0x770fc1f0.
+//
+// Solidity: function localTokenMessenger() view returns(address)
+func (_TokenMinter *TokenMinterSession) LocalTokenMessenger() (common.Address, error) {
+	return _TokenMinter.Contract.LocalTokenMessenger(&_TokenMinter.CallOpts)
+}
+
+// LocalTokenMessenger is a free data retrieval call binding the contract method 0x770fc1f0.
+//
+// Solidity: function localTokenMessenger() view returns(address)
+func (_TokenMinter *TokenMinterCallerSession) LocalTokenMessenger() (common.Address, error) {
+	return _TokenMinter.Contract.LocalTokenMessenger(&_TokenMinter.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_TokenMinter *TokenMinterCaller) Paused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _TokenMinter.contract.Call(opts, &out, "paused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_TokenMinter *TokenMinterSession) Paused() (bool, error) {
+	return _TokenMinter.Contract.Paused(&_TokenMinter.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_TokenMinter *TokenMinterCallerSession) Paused() (bool, error) {
+	return _TokenMinter.Contract.Paused(&_TokenMinter.CallOpts)
+}
+
+// RemoteTokensToLocalTokens is a free data retrieval call binding the contract method 0xeed3b9da.
+//
+// Solidity: function remoteTokensToLocalTokens(bytes32 ) view returns(address)
+func (_TokenMinter *TokenMinterCaller) RemoteTokensToLocalTokens(opts *bind.CallOpts, arg0 [32]byte) (common.Address, error) {
+	var out []interface{}
+	err := _TokenMinter.contract.Call(opts, &out, "remoteTokensToLocalTokens", arg0)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// RemoteTokensToLocalTokens is a free data retrieval call binding the contract method 0xeed3b9da.
+//
+// Solidity: function remoteTokensToLocalTokens(bytes32 ) view returns(address)
+func (_TokenMinter *TokenMinterSession) RemoteTokensToLocalTokens(arg0 [32]byte) (common.Address, error) {
+	return _TokenMinter.Contract.RemoteTokensToLocalTokens(&_TokenMinter.CallOpts, arg0)
+}
+
+// RemoteTokensToLocalTokens is a free data retrieval call binding the contract method 0xeed3b9da.
+//
+// Solidity: function remoteTokensToLocalTokens(bytes32 ) view returns(address)
+func (_TokenMinter *TokenMinterCallerSession) RemoteTokensToLocalTokens(arg0 [32]byte) (common.Address, error) {
+	return _TokenMinter.Contract.RemoteTokensToLocalTokens(&_TokenMinter.CallOpts, arg0)
+}
+
+// TokenController is a free data retrieval call binding the contract method 0xeddd9d82.
+//
+// Solidity: function tokenController() view returns(address)
+func (_TokenMinter *TokenMinterCaller) TokenController(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _TokenMinter.contract.Call(opts, &out, "tokenController")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// TokenController is a free data retrieval call binding the contract method 0xeddd9d82.
+//
+// Solidity: function tokenController() view returns(address)
+func (_TokenMinter *TokenMinterSession) TokenController() (common.Address, error) {
+	return _TokenMinter.Contract.TokenController(&_TokenMinter.CallOpts)
+}
+
+// TokenController is a free data retrieval call binding the contract method 0xeddd9d82.
+//
+// Solidity: function tokenController() view returns(address)
+func (_TokenMinter *TokenMinterCallerSession) TokenController() (common.Address, error) {
+	return _TokenMinter.Contract.TokenController(&_TokenMinter.CallOpts)
+}
+
+// SetMaxBurnAmountPerMessage is a paid mutator transaction binding the contract method 0x7235ea0c.
+//
+// Solidity: function setMaxBurnAmountPerMessage(address localToken, uint256 burnLimitPerMessage) returns()
+func (_TokenMinter *TokenMinterTransactor) SetMaxBurnAmountPerMessage(opts *bind.TransactOpts, localToken common.Address, burnLimitPerMessage *big.Int) (*types.Transaction, error) {
+	return _TokenMinter.contract.Transact(opts, "setMaxBurnAmountPerMessage", localToken, burnLimitPerMessage)
+}
+
+// SetMaxBurnAmountPerMessage is a paid mutator transaction binding the contract method 0x7235ea0c.
+//
+// Solidity: function setMaxBurnAmountPerMessage(address localToken, uint256 burnLimitPerMessage) returns()
+func (_TokenMinter *TokenMinterSession) SetMaxBurnAmountPerMessage(localToken common.Address, burnLimitPerMessage *big.Int) (*types.Transaction, error) {
+	return _TokenMinter.Contract.SetMaxBurnAmountPerMessage(&_TokenMinter.TransactOpts, localToken, burnLimitPerMessage)
+}
+
+// SetMaxBurnAmountPerMessage is a paid mutator transaction binding the contract method 0x7235ea0c.
+//
+// Solidity: function setMaxBurnAmountPerMessage(address localToken, uint256 burnLimitPerMessage) returns()
+func (_TokenMinter *TokenMinterTransactorSession) SetMaxBurnAmountPerMessage(localToken common.Address, burnLimitPerMessage *big.Int) (*types.Transaction, error) {
+	return _TokenMinter.Contract.SetMaxBurnAmountPerMessage(&_TokenMinter.TransactOpts, localToken, burnLimitPerMessage)
+}
+
+// TokenMinterSetBurnLimitPerMessageIterator is returned from FilterSetBurnLimitPerMessage and is used to iterate over the raw logs and unpacked data for SetBurnLimitPerMessage events raised by the TokenMinter contract.
+type TokenMinterSetBurnLimitPerMessageIterator struct {
+	Event *TokenMinterSetBurnLimitPerMessage // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TokenMinterSetBurnLimitPerMessageIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(TokenMinterSetBurnLimitPerMessage)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(TokenMinterSetBurnLimitPerMessage)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TokenMinterSetBurnLimitPerMessageIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TokenMinterSetBurnLimitPerMessageIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// TokenMinterSetBurnLimitPerMessage represents a SetBurnLimitPerMessage event raised by the TokenMinter contract.
+type TokenMinterSetBurnLimitPerMessage struct {
+	Token               common.Address
+	BurnLimitPerMessage *big.Int
+	Raw                 types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetBurnLimitPerMessage is a free log retrieval operation binding the contract event 0x6aa98de3efb0f031e70a96b4bd97fafc58474ad59f4e890aa3733bba321ecca2.
+//
+// Solidity: event SetBurnLimitPerMessage(address indexed token, uint256 burnLimitPerMessage)
+func (_TokenMinter *TokenMinterFilterer) FilterSetBurnLimitPerMessage(opts *bind.FilterOpts, token []common.Address) (*TokenMinterSetBurnLimitPerMessageIterator, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _TokenMinter.contract.FilterLogs(opts, "SetBurnLimitPerMessage", tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenMinterSetBurnLimitPerMessageIterator{contract: _TokenMinter.contract, event: "SetBurnLimitPerMessage", logs: logs, sub: sub}, nil
+}
+
+// WatchSetBurnLimitPerMessage is a free log subscription operation binding the contract event 0x6aa98de3efb0f031e70a96b4bd97fafc58474ad59f4e890aa3733bba321ecca2.
+//
+// Solidity: event SetBurnLimitPerMessage(address indexed token, uint256 burnLimitPerMessage)
+func (_TokenMinter *TokenMinterFilterer) WatchSetBurnLimitPerMessage(opts *bind.WatchOpts, sink chan<- *TokenMinterSetBurnLimitPerMessage, token []common.Address) (event.Subscription, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _TokenMinter.contract.WatchLogs(opts, "SetBurnLimitPerMessage", tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(TokenMinterSetBurnLimitPerMessage)
+				if err := _TokenMinter.contract.UnpackLog(event, "SetBurnLimitPerMessage", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetBurnLimitPerMessage is a log parse operation binding the contract event 0x6aa98de3efb0f031e70a96b4bd97fafc58474ad59f4e890aa3733bba321ecca2.
+//
+// Solidity: event SetBurnLimitPerMessage(address indexed token, uint256 burnLimitPerMessage)
+func (_TokenMinter *TokenMinterFilterer) ParseSetBurnLimitPerMessage(log types.Log) (*TokenMinterSetBurnLimitPerMessage, error) {
+	event := new(TokenMinterSetBurnLimitPerMessage)
+	if err := _TokenMinter.contract.UnpackLog(event, "SetBurnLimitPerMessage", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -11,8 +11,9 @@ import (
 	"github.com/G7DAO/bifrost/bindings/ERC20Inbox"
 	"github.com/G7DAO/bifrost/bindings/L1GatewayRouter"
 	"github.com/G7DAO/bifrost/bindings/NodeInterface"
+	"github.com/G7DAO/bifrost/cmd/safe"
+	"github.com/G7DAO/bifrost/cmd/transaction"
 	"github.com/ethereum/go-ethereum/accounts/abi"
-	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -60,7 +61,7 @@ func GetNativeTokenBridgeCalldata(key *keystore.Key, l1Client *ethclient.Client,
 	return createRetryableTicketData, nil
 }
 
-func NativeTokenBridgeCall(inboxAddress common.Address, keyFile string, password string, l1Rpc string, l2Rpc string, to common.Address, l2CallValue *big.Int, l2Calldata []byte) (*types.Transaction, error) {
+func NativeTokenBridgeCall(inboxAddress common.Address, keyFile string, password string, l1Rpc string, l2Rpc string, to common.Address, l2CallValue *big.Int, l2Calldata []byte, txFlags *transaction.Flags) (*types.Transaction, error) {
 	l1Client, l1ClientErr := ethclient.DialContext(context.Background(), l1Rpc)
 	if l1ClientErr != nil {
 		return nil, l1ClientErr
@@ -71,7 +72,7 @@ func NativeTokenBridgeCall(inboxAddress common.Address, keyFile string, password
 		return nil, l2ClientErr
 	}
 
-	key, keyErr := NodeInterface.KeyFromFile(keyFile, password)
+	key, keyErr := txFlags.Key(keyFile, password)
 	if keyErr != nil {
 		return nil, keyErr
 	}
@@ -82,22 +83,16 @@ func NativeTokenBridgeCall(inboxAddress common.Address, keyFile string, password
 	}
 
 	fmt.Println("Sending transaction...")
-	transaction, transactionErr := SendTransaction(l1Client, key, password, createRetryableTicketData, inboxAddress.Hex(), big.NewInt(0))
+	tx, receipt, transactionErr := transaction.SendWithReceipt(context.Background(), l1Client, key, inboxAddress, big.NewInt(0), createRetryableTicketData, txFlags)
 	if transactionErr != nil {
 		fmt.Fprintln(os.Stderr, transactionErr.Error())
 		return nil, transactionErr
 	}
-	fmt.Println("Transaction sent! Transaction hash:", transaction.Hash().Hex())
-
-	fmt.Println("Waiting for transaction to be mined...")
-	_, receiptErr := bind.WaitMined(context.Background(), l1Client, transaction)
-	if receiptErr != nil {
-		fmt.Fprintln(os.Stderr, receiptErr.Error())
-		return nil, receiptErr
+	if receipt != nil {
+		fmt.Println("Transaction mined in block", receipt.BlockNumber.String())
 	}
-	fmt.Println("Transaction mined!")
 
-	return transaction, nil
+	return tx, nil
 }
 
 func NativeTokenBridgePropose(inboxAddress common.Address, keyFile string, password string, l1Rpc string, l2Rpc string, to common.Address, l2CallValue *big.Int, l2Calldata []byte, safeAddress common.Address, safeApi string, safeOperation uint8, safeNonce *big.Int) error {
@@ -121,7 +116,7 @@ func NativeTokenBridgePropose(inboxAddress common.Address, keyFile string, passw
 		return createRetryableTicketDataErr
 	}
 
-	return CreateSafeProposal(l1Client, key, safeAddress, inboxAddress, createRetryableTicketData, big.NewInt(0), safeApi, OperationType(safeOperation), safeNonce)
+	return safe.CreateSafeProposal(l1Client, key, safeAddress, inboxAddress, createRetryableTicketData, big.NewInt(0), safeApi, safe.OperationType(safeOperation), safeNonce)
 }
 
 func GetERC20BridgeCalldataAndValue(routerAddress common.Address, key *keystore.Key, l1Rpc string, l2Rpc string, tokenAddress common.Address, to common.Address, amount *big.Int) ([]byte, *big.Int, error) {
@@ -216,8 +211,8 @@ func GetERC20BridgeCalldataAndValue(routerAddress common.Address, key *keystore.
 	return callData, tokenTotalFeeAmount, nil
 }
 
-func ERC20BridgeCall(routerAddress common.Address, keyFile string, password string, l1Rpc string, l2Rpc string, tokenAddress common.Address, to common.Address, amount *big.Int, customNativeToken bool) (*types.Transaction, error) {
-	key, keyErr := NodeInterface.KeyFromFile(keyFile, password)
+func ERC20BridgeCall(routerAddress common.Address, keyFile string, password string, l1Rpc string, l2Rpc string, tokenAddress common.Address, to common.Address, amount *big.Int, customNativeToken bool, txFlags *transaction.Flags) (*types.Transaction, error) {
+	key, keyErr := txFlags.Key(keyFile, password)
 	if keyErr != nil {
 		fmt.Fprintln(os.Stderr, "keyErr", keyErr.Error())
 		return nil, keyErr
@@ -241,22 +236,16 @@ func ERC20BridgeCall(routerAddress common.Address, keyFile string, password stri
 	if customNativeToken {
 		tokenTotalFeeAmount = big.NewInt(0)
 	}
-	transaction, transactionErr := SendTransaction(l1Client, key, password, callData, routerAddress.Hex(), tokenTotalFeeAmount)
+	tx, receipt, transactionErr := transaction.SendWithReceipt(context.Background(), l1Client, key, routerAddress, tokenTotalFeeAmount, callData, txFlags)
 	if transactionErr != nil {
 		fmt.Fprintln(os.Stderr, "transactionErr", transactionErr.Error())
 		return nil, transactionErr
 	}
-	fmt.Println("Transaction sent! Transaction hash:", transaction.Hash().Hex())
-
-	fmt.Println("Waiting for transaction to be mined...")
-	_, receiptErr := bind.WaitMined(context.Background(), l1Client, transaction)
-	if receiptErr != nil {
-		fmt.Fprintln(os.Stderr, "receiptErr", receiptErr.Error())
-		return nil, receiptErr
+	if receipt != nil {
+		fmt.Println("Transaction mined in block", receipt.BlockNumber.String())
 	}
-	fmt.Println("Transaction mined!")
 
-	return transaction, nil
+	return tx, nil
 }
 
 func ERC20BridgePropose(routerAddress common.Address, keyFile string, password string, l1Rpc string, l2Rpc string, tokenAddress common.Address, to common.Address, amount *big.Int, safeAddress common.Address, safeApi string, safeOperation uint8, safeNonce *big.Int, customNativeToken bool) error {
@@ -282,5 +271,5 @@ func ERC20BridgePropose(routerAddress common.Address, keyFile string, password s
 		tokenTotalFeeAmount = big.NewInt(0)
 	}
 
-	return CreateSafeProposal(l1Client, key, safeAddress, routerAddress, callData, tokenTotalFeeAmount, safeApi, OperationType(safeOperation), safeNonce)
+	return safe.CreateSafeProposal(l1Client, key, safeAddress, routerAddress, callData, tokenTotalFeeAmount, safeApi, safe.OperationType(safeOperation), safeNonce)
 }
@@ -1,14 +1,14 @@
 package arbitrum_bifrost
 
 import (
-	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"math/big"
 
+	"github.com/G7DAO/bifrost/cmd/safe"
+	"github.com/G7DAO/bifrost/cmd/transaction"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/spf13/cobra"
 )
 
@@ -43,12 +43,12 @@ func CreateBridgeNativeTokenCommand() *cobra.Command {
 }
 
 func CreateBridgeNativeTokenL1ToL2Command() *cobra.Command {
-	var keyFile, password, l1Rpc, l2Rpc, inboxRaw, toRaw, l2CallValueRaw, l2CalldataRaw, safeAddressRaw, safeApi, safeNonceRaw string
-	var inboxAddress, to, safeAddress common.Address
+	var keyFile, password, l1Rpc, l2Rpc, inboxRaw, toRaw, l2CallValueRaw, l2CalldataRaw string
+	var inboxAddress, to common.Address
 	var l2CallValue *big.Int
 	var l2Calldata []byte
-	var safeOperation uint8
-	var safeNonce *big.Int
+	safeFlags := &safe.Flags{}
+	txFlags := &transaction.Flags{}
 
 	createCmd := &cobra.Command{
 		Use:   "l1-to-l2",
@@ -85,64 +85,41 @@ func CreateBridgeNativeTokenL1ToL2Command() *cobra.Command {
 				}
 			}
 
-			if keyFile == "" {
+			if keyFile == "" && !txFlags.Unsigned() {
 				return errors.New("keyfile is required")
 			}
 
-			if safeAddressRaw != "" {
-				if !common.IsHexAddress(safeAddressRaw) {
-					return fmt.Errorf("--safe is not a valid Ethereum address")
-				} else {
-					safeAddress = common.HexToAddress(safeAddressRaw)
-				}
-
-				if safeApi == "" {
-					client, clientErr := ethclient.DialContext(context.Background(), l1Rpc)
-					if clientErr != nil {
-						return clientErr
-					}
-
-					chainID, chainIDErr := client.ChainID(context.Background())
-					if chainIDErr != nil {
-						return chainIDErr
-					}
-					safeApi = "https://safe-client.safe.global/v1/chains/" + chainID.String() + "/transactions/" + safeAddress.Hex() + "/propose"
-					fmt.Println("--safe-api not specified, using default (", safeApi, ")")
-				}
-
-				if OperationType(safeOperation).String() == "Unknown" {
-					return fmt.Errorf("--safe-operation must be 0 (Call) or 1 (DelegateCall)")
-				}
+			if safeErr := safeFlags.Parse(l1Rpc); safeErr != nil {
+				return safeErr
+			}
+			if safeFlags.IsSet() && txFlags.Unsigned() {
+				return errors.New("--unsigned-out cannot be used with --safe, the Safe transaction is proposed instead")
+			}
 
-				if safeNonceRaw != "" {
-					safeNonce = new(big.Int)
-					_, ok := safeNonce.SetString(safeNonceRaw, 0)
-					if !ok {
-						return fmt.Errorf("--safe-nonce is not a valid big integer")
-					}
-				} else {
-					fmt.Println("--safe-nonce not specified, fetching from Safe")
-				}
+			if txErr := txFlags.Parse(); txErr != nil {
+				return txErr
 			}
 
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			fmt.Println("Bridging to", to.Hex())
-			if safeAddressRaw != "" {
-				err := NativeTokenBridgePropose(inboxAddress, keyFile, password, l1Rpc, l2Rpc, to, l2CallValue, l2Calldata, safeAddress, safeApi, safeOperation, safeNonce)
+			if safeFlags.IsSet() {
+				err := NativeTokenBridgePropose(inboxAddress, keyFile, password, l1Rpc, l2Rpc, to, l2CallValue, l2Calldata, safeFlags.Address, safeFlags.Api, safeFlags.Operation, safeFlags.Nonce)
 				if err != nil {
 					fmt.Fprintln(cmd.ErrOrStderr(), err.Error())
 					return err
 				}
 			} else {
-				transaction, transactionErr := NativeTokenBridgeCall(inboxAddress, keyFile, password, l1Rpc, l2Rpc, to, l2CallValue, l2Calldata)
+				transaction, transactionErr := NativeTokenBridgeCall(inboxAddress, keyFile, password, l1Rpc, l2Rpc, to, l2CallValue, l2Calldata, txFlags)
 				if transactionErr != nil {
 					fmt.Fprintln(cmd.ErrOrStderr(), transactionErr.Error())
 					return transactionErr
 				}
 
-				fmt.Println("Transaction sent:", transaction.Hash().Hex())
+				if !txFlags.Unsigned() {
+					fmt.Println("Transaction sent:", transaction.Hash().Hex())
+				}
 			}
 
 			return nil
@@ -157,10 +134,8 @@ func CreateBridgeNativeTokenL1ToL2Command() *cobra.Command {
 	createCmd.Flags().StringVar(&toRaw, "to", "", "Recipient or contract address")
 	createCmd.Flags().StringVar(&l2CallValueRaw, "amount", "", "L2 call value")
 	createCmd.Flags().StringVar(&l2CalldataRaw, "l2-calldata", "", "Calldata to send")
-	createCmd.Flags().StringVar(&safeAddressRaw, "safe", "", "Address of the Safe contract")
-	createCmd.Flags().StringVar(&safeApi, "safe-api", "", "Safe API for the Safe Transaction Service (optional)")
-	createCmd.Flags().Uint8Var(&safeOperation, "safe-operation", 0, "Safe operation type: 0 (Call) or 1 (DelegateCall)")
-	createCmd.Flags().StringVar(&safeNonceRaw, "safe-nonce", "", "Safe nonce")
+	safeFlags.AddFlags(createCmd)
+	txFlags.AddFlags(createCmd)
 
 	return createCmd
 }
@@ -169,6 +144,7 @@ func CreateBridgeNativeTokenL1ToL3Command() *cobra.Command {
 	var keyFile, password, l1TokenRaw, l3FeeTokenL1AddrRaw, l1l2RouterRaw, l2l3RouterOrInboxRaw, toRaw, amountRaw, l3CalldataRaw, l1Rpc, l2Rpc, l3Rpc, teleporterAddressRaw string
 	teleportParams := &TeleportParams{}
 	var teleporterAddress common.Address
+	txFlags := &transaction.Flags{}
 
 	var l3CallDataErr error
 
@@ -226,20 +202,26 @@ func CreateBridgeNativeTokenL1ToL3Command() *cobra.Command {
 			}
 			teleporterAddress = common.HexToAddress(teleporterAddressRaw)
 
-			if keyFile == "" {
+			if keyFile == "" && !txFlags.Unsigned() {
 				return errors.New("keyfile is required")
 			}
 
+			if txErr := txFlags.Parse(); txErr != nil {
+				return txErr
+			}
+
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			transaction, transactionErr := Teleport(teleporterAddress, teleportParams, keyFile, password, l1Rpc, l2Rpc, l3Rpc)
+			transaction, transactionErr := Teleport(teleporterAddress, teleportParams, keyFile, password, l1Rpc, l2Rpc, l3Rpc, txFlags)
 			if transactionErr != nil {
 				fmt.Fprintln(cmd.ErrOrStderr(), transactionErr.Error())
 				return transactionErr
 			}
 
-			fmt.Println("Done! Transaction hash:", transaction.Hash().Hex())
+			if !txFlags.Unsigned() {
+				fmt.Println("Done! Transaction hash:", transaction.Hash().Hex())
+			}
 
 			return nil
 		},
@@ -258,6 +240,7 @@ func CreateBridgeNativeTokenL1ToL3Command() *cobra.Command {
 	createCmd.Flags().StringVar(&l2Rpc, "l2-rpc", "", "L2 RPC URL")
 	createCmd.Flags().StringVar(&l3Rpc, "l3-rpc", "", "L3 RPC URL")
 	createCmd.Flags().StringVar(&teleporterAddressRaw, "teleporter", "", "Teleporter contract address")
+	txFlags.AddFlags(createCmd)
 
 	return createCmd
 }
@@ -277,11 +260,11 @@ func CreateBridgeERC20Command() *cobra.Command {
 }
 
 func CreateBridgeERC20L1ToL2Command() *cobra.Command {
-	var keyFile, password, l1Rpc, l2Rpc, routerRaw, tokenAddressRaw, toRaw, amountRaw, safeAddressRaw, safeApi, safeNonceRaw string
-	var routerAddress, tokenAddress, to, safeAddress common.Address
+	var keyFile, password, l1Rpc, l2Rpc, routerRaw, tokenAddressRaw, toRaw, amountRaw string
+	var routerAddress, tokenAddress, to common.Address
 	var amount *big.Int
-	var safeOperation uint8
-	var safeNonce *big.Int
+	safeFlags := &safe.Flags{}
+	txFlags := &transaction.Flags{}
 	var isCustomNativeToken bool
 
 	createCmd := &cobra.Command{
@@ -316,45 +299,22 @@ func CreateBridgeERC20L1ToL2Command() *cobra.Command {
 				amount.SetInt64(0)
 			}
 
-			if keyFile == "" {
+			if keyFile == "" && !txFlags.Unsigned() {
 				return errors.New("keyfile is required")
 			}
 
-			if safeAddressRaw != "" {
-				if !common.IsHexAddress(safeAddressRaw) {
-					return fmt.Errorf("--safe is not a valid Ethereum address")
-				} else {
-					safeAddress = common.HexToAddress(safeAddressRaw)
-				}
-
-				if safeApi == "" {
-					client, clientErr := ethclient.DialContext(context.Background(), l1Rpc)
-					if clientErr != nil {
-						return clientErr
-					}
-
-					chainID, chainIDErr := client.ChainID(context.Background())
-					if chainIDErr != nil {
-						return chainIDErr
-					}
-					safeApi = "https://safe-client.safe.global/v1/chains/" + chainID.String() + "/transactions/" + safeAddress.Hex() + "/propose"
-					fmt.Println("--safe-api not specified, using default (", safeApi, ")")
-				}
-
-				if OperationType(safeOperation).String() == "Unknown" {
-					return fmt.Errorf("--safe-operation must be 0 (Call) or 1 (DelegateCall)")
-				}
+			if safeErr := safeFlags.Parse(l1Rpc); safeErr != nil {
+				return safeErr
+			}
+			if safeFlags.IsSet() && txFlags.Unsigned() {
+				return errors.New("--unsigned-out cannot be used with --safe, the Safe transaction is proposed instead")
+			}
 
-				if safeNonceRaw != "" {
-					safeNonce = new(big.Int)
-					_, ok := safeNonce.SetString(safeNonceRaw, 0)
-					if !ok {
-						return fmt.Errorf("--safe-nonce is not a valid big integer")
-					}
-				} else {
-					fmt.Println("--safe-nonce not specified, fetching from Safe")
-				}
+			if txErr := txFlags.Parse(); txErr != nil {
+				return txErr
+			}
 
+			if safeFlags.IsSet() {
 				if l1Rpc == "" {
 					return errors.New("l1-rpc is required")
 				}
@@ -368,15 +328,17 @@ func CreateBridgeERC20L1ToL2Command() *cobra.Command {
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			fmt.Println("Bridging", tokenAddress.Hex(), "to", to.Hex())
-			if safeAddressRaw == "" {
-				transaction, transactionErr := ERC20BridgeCall(routerAddress, keyFile, password, l1Rpc, l2Rpc, tokenAddress, to, amount, isCustomNativeToken)
+			if !safeFlags.IsSet() {
+				transaction, transactionErr := ERC20BridgeCall(routerAddress, keyFile, password, l1Rpc, l2Rpc, tokenAddress, to, amount, isCustomNativeToken, txFlags)
 				if transactionErr != nil {
 					fmt.Fprintln(cmd.ErrOrStderr(), transactionErr.Error())
 					return transactionErr
 				}
-				fmt.Println("Transaction sent:", transaction.Hash().Hex())
+				if !txFlags.Unsigned() {
+					fmt.Println("Transaction sent:", transaction.Hash().Hex())
+				}
 			} else {
-				proposeErr := ERC20BridgePropose(routerAddress, keyFile, password, l1Rpc, l2Rpc, tokenAddress, to, amount, safeAddress, safeApi, safeOperation, safeNonce, isCustomNativeToken)
+				proposeErr := ERC20BridgePropose(routerAddress, keyFile, password, l1Rpc, l2Rpc, tokenAddress, to, amount, safeFlags.Address, safeFlags.Api, safeFlags.Operation, safeFlags.Nonce, isCustomNativeToken)
 				if proposeErr != nil {
 					fmt.Fprintln(cmd.ErrOrStderr(), proposeErr.Error())
 					return proposeErr
@@ -395,10 +357,8 @@ func CreateBridgeERC20L1ToL2Command() *cobra.Command {
 	createCmd.Flags().StringVar(&toRaw, "to", "", "Recipient address")
 	createCmd.Flags().StringVar(&tokenAddressRaw, "token", "", "Token address")
 	createCmd.Flags().StringVar(&amountRaw, "amount", "", "Amount to send")
-	createCmd.Flags().StringVar(&safeAddressRaw, "safe", "", "Address of the Safe contract")
-	createCmd.Flags().StringVar(&safeApi, "safe-api", "", "Safe API for the Safe Transaction Service (optional)")
-	createCmd.Flags().Uint8Var(&safeOperation, "safe-operation", 0, "Safe operation type: 0 (Call) or 1 (DelegateCall)")
-	createCmd.Flags().StringVar(&safeNonceRaw, "safe-nonce", "", "Safe nonce")
+	safeFlags.AddFlags(createCmd)
+	txFlags.AddFlags(createCmd)
 	createCmd.Flags().BoolVar(&isCustomNativeToken, "custom-native-token", false, "Is custom native token")
 
 	return createCmd
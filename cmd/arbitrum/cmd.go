@@ -1,14 +1,14 @@
 package arbitrum_bifrost
 
 import (
-	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"math/big"
 
+	"github.com/G7DAO/bifrost/cmd/safe"
+	"github.com/G7DAO/bifrost/cmd/transaction"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/spf13/cobra"
 )
 
@@ -26,12 +26,12 @@ func CreateArbitrumCommand() *cobra.Command {
 }
 
 func CreateArbitrumMessageCommand() *cobra.Command {
-	var keyFile, password, l1Rpc, l2Rpc, inboxRaw, toRaw, l2CallValueRaw, l2CalldataRaw, safeAddressRaw, safeApi, safeNonceRaw string
-	var inboxAddress, to, safeAddress common.Address
+	var keyFile, password, l1Rpc, l2Rpc, inboxRaw, toRaw, l2CallValueRaw, l2CalldataRaw string
+	var inboxAddress, to common.Address
 	var l2CallValue *big.Int
 	var l2Calldata []byte
-	var safeOperation uint8
-	var safeNonce *big.Int
+	safeFlags := &safe.Flags{}
+	txFlags := &transaction.Flags{}
 
 	messageCmd := &cobra.Command{
 		Use:   "message",
@@ -68,64 +68,41 @@ func CreateArbitrumMessageCommand() *cobra.Command {
 				}
 			}
 
-			if keyFile == "" {
+			if keyFile == "" && !txFlags.Unsigned() {
 				return errors.New("keyfile is required")
 			}
 
-			if safeAddressRaw != "" {
-				if !common.IsHexAddress(safeAddressRaw) {
-					return fmt.Errorf("--safe is not a valid Ethereum address")
-				} else {
-					safeAddress = common.HexToAddress(safeAddressRaw)
-				}
-
-				if safeApi == "" {
-					client, clientErr := ethclient.DialContext(context.Background(), l1Rpc)
-					if clientErr != nil {
-						return clientErr
-					}
-
-					chainID, chainIDErr := client.ChainID(context.Background())
-					if chainIDErr != nil {
-						return chainIDErr
-					}
-					safeApi = "https://safe-client.safe.global/v1/chains/" + chainID.String() + "/transactions/" + safeAddress.Hex() + "/propose"
-					fmt.Println("--safe-api not specified, using default (", safeApi, ")")
-				}
-
-				if OperationType(safeOperation).String() == "Unknown" {
-					return fmt.Errorf("--safe-operation must be 0 (Call) or 1 (DelegateCall)")
-				}
+			if safeErr := safeFlags.Parse(l1Rpc); safeErr != nil {
+				return safeErr
+			}
+			if safeFlags.IsSet() && txFlags.Unsigned() {
+				return errors.New("--unsigned-out cannot be used with --safe, the Safe transaction is proposed instead")
+			}
 
-				if safeNonceRaw != "" {
-					safeNonce = new(big.Int)
-					_, ok := safeNonce.SetString(safeNonceRaw, 0)
-					if !ok {
-						return fmt.Errorf("--safe-nonce is not a valid big integer")
-					}
-				} else {
-					fmt.Println("--safe-nonce not specified, fetching from Safe")
-				}
+			if txErr := txFlags.Parse(); txErr != nil {
+				return txErr
 			}
 
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			fmt.Println("Bridging to", to.Hex())
-			if safeAddressRaw != "" {
-				err := NativeTokenBridgePropose(inboxAddress, keyFile, password, l1Rpc, l2Rpc, to, l2CallValue, l2Calldata, safeAddress, safeApi, safeOperation, safeNonce)
+			if safeFlags.IsSet() {
+				err := NativeTokenBridgePropose(inboxAddress, keyFile, password, l1Rpc, l2Rpc, to, l2CallValue, l2Calldata, safeFlags.Address, safeFlags.Api, safeFlags.Operation, safeFlags.Nonce)
 				if err != nil {
 					fmt.Fprintln(cmd.ErrOrStderr(), err.Error())
 					return err
 				}
 			} else {
-				transaction, transactionErr := NativeTokenBridgeCall(inboxAddress, keyFile, password, l1Rpc, l2Rpc, to, l2CallValue, l2Calldata)
+				transaction, transactionErr := NativeTokenBridgeCall(inboxAddress, keyFile, password, l1Rpc, l2Rpc, to, l2CallValue, l2Calldata, txFlags)
 				if transactionErr != nil {
 					fmt.Fprintln(cmd.ErrOrStderr(), transactionErr.Error())
 					return transactionErr
 				}
 
-				fmt.Println("Transaction sent:", transaction.Hash().Hex())
+				if !txFlags.Unsigned() {
+					fmt.Println("Transaction sent:", transaction.Hash().Hex())
+				}
 			}
 
 			return nil
@@ -140,10 +117,8 @@ func CreateArbitrumMessageCommand() *cobra.Command {
 	messageCmd.Flags().StringVar(&toRaw, "to", "", "Recipient or contract address")
 	messageCmd.Flags().StringVar(&l2CallValueRaw, "amount", "", "L2 call value")
 	messageCmd.Flags().StringVar(&l2CalldataRaw, "l2-calldata", "", "Calldata to send")
-	messageCmd.Flags().StringVar(&safeAddressRaw, "safe", "", "Address of the Safe contract")
-	messageCmd.Flags().StringVar(&safeApi, "safe-api", "", "Safe API for the Safe Transaction Service (optional)")
-	messageCmd.Flags().Uint8Var(&safeOperation, "safe-operation", 0, "Safe operation type: 0 (Call) or 1 (DelegateCall)")
-	messageCmd.Flags().StringVar(&safeNonceRaw, "safe-nonce", "", "Safe nonce")
+	safeFlags.AddFlags(messageCmd)
+	txFlags.AddFlags(messageCmd)
 
 	return messageCmd
 }
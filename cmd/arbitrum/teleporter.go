@@ -7,14 +7,14 @@ import (
 	"strings"
 
 	"github.com/G7DAO/bifrost/bindings/L1Teleporter"
-	"github.com/G7DAO/bifrost/bindings/NodeInterface"
+	"github.com/G7DAO/bifrost/cmd/transaction"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 )
 
-func Teleport(teleporter common.Address, teleportParams *TeleportParams, keyFile string, password string, l1Rpc string, l2Rpc string, l3Rpc string) (*types.Transaction, error) {
+func Teleport(teleporter common.Address, teleportParams *TeleportParams, keyFile string, password string, l1Rpc string, l2Rpc string, l3Rpc string, txFlags *transaction.Flags) (*types.Transaction, error) {
 	l1Client, l1ClientErr := ethclient.DialContext(context.Background(), l1Rpc)
 	if l1ClientErr != nil {
 		return nil, l1ClientErr
@@ -30,7 +30,7 @@ func Teleport(teleporter common.Address, teleportParams *TeleportParams, keyFile
 		return nil, l3ClientErr
 	}
 
-	key, keyErr := NodeInterface.KeyFromFile(keyFile, password)
+	key, keyErr := txFlags.Key(keyFile, password)
 	if keyErr != nil {
 		return nil, keyErr
 	}
@@ -64,11 +64,11 @@ func Teleport(teleporter common.Address, teleportParams *TeleportParams, keyFile
 		return nil, dataErr
 	}
 
-	transaction, transactionErr := SendTransaction(l1Client, key, password, data, teleporter.String(), requiredEth)
-	if transactionErr != nil {
-		fmt.Fprintln(os.Stderr, transactionErr.Error())
-		return nil, transactionErr
+	tx, err := transaction.Send(context.Background(), l1Client, key, teleporter, requiredEth, data, txFlags)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return nil, err
 	}
 
-	return transaction, nil
+	return tx, nil
 }
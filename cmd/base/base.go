@@ -19,6 +19,11 @@ func CreateBaseCommand() *cobra.Command {
 	optimismMintableERC20FactoryCmd := OptimismMintableERC20Factory.CreateOptimismMintableERC20FactoryCommand()
 
 	baseCmd.AddCommand(l1StandardBridgeCmd, optimismMintableERC20FactoryCmd)
+	baseCmd.AddCommand(CreateBaseBridgeCommand())
+	baseCmd.AddCommand(CreateDepositCommand())
+	baseCmd.AddCommand(CreateMessageCommand())
+	baseCmd.AddCommand(CreateTokenCommand())
+	baseCmd.AddCommand(CreateWithdrawCommand())
 
 	return baseCmd
 }
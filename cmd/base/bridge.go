@@ -0,0 +1,214 @@
+package base
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/G7DAO/bifrost/bindings/ERC20"
+	"github.com/G7DAO/bifrost/bindings/L1StandardBridge"
+	"github.com/G7DAO/bifrost/bindings/OptimismMintableERC20"
+	"github.com/G7DAO/bifrost/cmd/safe"
+	"github.com/G7DAO/bifrost/cmd/transaction"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// Minimum gas limits of the message relayed to L2. The gas used by the relay on L2 is paid on L1
+// through the deposit, so they only need to cover finalizeBridgeETH or finalizeBridgeERC20, and the
+// mint of an OptimismMintableERC20, with headroom. A recipient contract whose receive hook does more
+// needs a higher --min-gas-limit.
+const (
+	DefaultETHMinGasLimit   uint32 = 200_000
+	DefaultERC20MinGasLimit uint32 = 200_000
+)
+
+func GetBridgeETHToCalldata(to common.Address, minGasLimit uint32, extraData []byte) ([]byte, error) {
+	bridgeAbi, err := L1StandardBridge.L1StandardBridgeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	return bridgeAbi.Pack("bridgeETHTo", to, minGasLimit, extraData)
+}
+
+func GetBridgeERC20ToCalldata(localToken common.Address, remoteToken common.Address, to common.Address, amount *big.Int, minGasLimit uint32, extraData []byte) ([]byte, error) {
+	bridgeAbi, err := L1StandardBridge.L1StandardBridgeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	return bridgeAbi.Pack("bridgeERC20To", localToken, remoteToken, to, amount, minGasLimit, extraData)
+}
+
+// GetRemoteToken returns the L1 token of an OptimismMintableERC20 on L2, falling back to the legacy
+// l1Token getter of tokens deployed before remoteToken was introduced
+func GetRemoteToken(l2Client *ethclient.Client, l2Token common.Address) (common.Address, error) {
+	token, err := OptimismMintableERC20.NewOptimismMintableERC20(l2Token, l2Client)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	remoteToken, remoteTokenErr := token.RemoteToken(&bind.CallOpts{})
+	if remoteTokenErr == nil {
+		return remoteToken, nil
+	}
+
+	remoteToken, l1TokenErr := token.L1Token(&bind.CallOpts{})
+	if l1TokenErr != nil {
+		return common.Address{}, fmt.Errorf("failed to fetch the remote token of %s, is it an OptimismMintableERC20? %v", l2Token.Hex(), remoteTokenErr)
+	}
+	return remoteToken, nil
+}
+
+// ERC165 interface IDs of the tokens L2StandardBridge mints and burns
+// Source: https://github.com/ethereum-optimism/optimism/blob/develop/packages/contracts-bedrock/src/universal/interfaces/IOptimismMintableERC20.sol
+var (
+	// OptimismMintableERC20InterfaceID is remoteToken ^ bridge ^ mint ^ burn
+	OptimismMintableERC20InterfaceID = [4]byte{0xec, 0x4f, 0xc8, 0xe3}
+	// LegacyMintableERC20InterfaceID is l1Token ^ mint ^ burn
+	LegacyMintableERC20InterfaceID = [4]byte{0x1d, 0x1d, 0x8b, 0x63}
+)
+
+// ValidateTokenPair checks from L2 that the L2 token is an OptimismMintableERC20, per ERC165, that
+// bridges the L1 token and is minted by the L2StandardBridge
+func ValidateTokenPair(l2Client *ethclient.Client, l1Token common.Address, l2Token common.Address) error {
+	token, err := OptimismMintableERC20.NewOptimismMintableERC20(l2Token, l2Client)
+	if err != nil {
+		return err
+	}
+
+	supported := false
+	for _, interfaceID := range [][4]byte{OptimismMintableERC20InterfaceID, LegacyMintableERC20InterfaceID} {
+		if ok, supportsErr := token.SupportsInterface(&bind.CallOpts{}, interfaceID); supportsErr == nil && ok {
+			supported = true
+			break
+		}
+	}
+	if !supported {
+		return fmt.Errorf("L2 token %s does not support IOptimismMintableERC20 (ERC165)", l2Token.Hex())
+	}
+
+	remoteToken, err := GetRemoteToken(l2Client, l2Token)
+	if err != nil {
+		return err
+	}
+	if remoteToken != l1Token {
+		return fmt.Errorf("L2 token %s bridges %s, not %s", l2Token.Hex(), remoteToken.Hex(), l1Token.Hex())
+	}
+
+	bridge, err := GetTokenBridge(l2Client, l2Token)
+	if err != nil {
+		return err
+	}
+	if bridge != L2StandardBridgeAddress {
+		return fmt.Errorf("L2 token %s is minted by %s, not by the L2StandardBridge %s", l2Token.Hex(), bridge.Hex(), L2StandardBridgeAddress.Hex())
+	}
+
+	return nil
+}
+
+// ResolveTokenPair returns the L1 token bridged to the L2 token, defaulting to the remote token of the
+// L2 token if l1Token is not set, and validates the pair. With force, an invalid pair is only reported.
+func ResolveTokenPair(l2Client *ethclient.Client, l1Token common.Address, l2Token common.Address, force bool) (common.Address, error) {
+	if l1Token == (common.Address{}) {
+		remoteToken, err := GetRemoteToken(l2Client, l2Token)
+		if err != nil {
+			return common.Address{}, err
+		}
+		fmt.Println("--token not specified, using the remote token of", l2Token.Hex(), "(", remoteToken.Hex(), ")")
+		l1Token = remoteToken
+	}
+
+	if err := ValidateTokenPair(l2Client, l1Token, l2Token); err != nil {
+		if !force {
+			return common.Address{}, fmt.Errorf("%v, the deposit could not be minted on L2, use --force to bridge anyway", err)
+		}
+		fmt.Println("Warning:", err.Error(), "bridging anyway because of --force")
+	}
+	return l1Token, nil
+}
+
+func ETHDepositCall(key *keystore.Key, l1Client *ethclient.Client, bridgeAddress common.Address, to common.Address, amount *big.Int, minGasLimit uint32, extraData []byte, txFlags *transaction.Flags) (*types.Transaction, error) {
+	bridgeETHToData, err := GetBridgeETHToCalldata(to, minGasLimit, extraData)
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction.Send(context.Background(), l1Client, key, bridgeAddress, amount, bridgeETHToData, txFlags)
+}
+
+func ETHDepositPropose(key *keystore.Key, l1Client *ethclient.Client, bridgeAddress common.Address, to common.Address, amount *big.Int, minGasLimit uint32, extraData []byte, safeFlags *safe.Flags) error {
+	bridgeETHToData, err := GetBridgeETHToCalldata(to, minGasLimit, extraData)
+	if err != nil {
+		return err
+	}
+
+	return safe.CreateSafeProposal(l1Client, key, safeFlags.Address, bridgeAddress, bridgeETHToData, amount, safeFlags.Api, safe.Call, safeFlags.Nonce)
+}
+
+// ERC20DepositCall approves the L1StandardBridge if its allowance is short and calls bridgeERC20To
+func ERC20DepositCall(key *keystore.Key, l1Client *ethclient.Client, bridgeAddress common.Address, l1Token common.Address, l2Token common.Address, to common.Address, amount *big.Int, minGasLimit uint32, extraData []byte, txFlags *transaction.Flags) (*types.Transaction, error) {
+	erc20, err := ERC20.NewERC20(l1Token, l1Client)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := erc20.BalanceOf(&bind.CallOpts{}, key.Address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch balance of %s: %v", key.Address.Hex(), err)
+	}
+	if balance.Cmp(amount) < 0 {
+		return nil, fmt.Errorf("insufficient balance: %s, need %s", balance.String(), amount.String())
+	}
+
+	allowance, err := erc20.Allowance(&bind.CallOpts{}, key.Address, bridgeAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch allowance of %s: %v", key.Address.Hex(), err)
+	}
+	if allowance.Cmp(amount) < 0 {
+		fmt.Println("Allowance of", bridgeAddress.Hex(), "is", allowance.String(), "approving", amount.String())
+		if err := transaction.Approve(context.Background(), l1Client, key, l1Token, bridgeAddress, amount, txFlags); err != nil {
+			return nil, err
+		}
+	}
+
+	bridgeERC20ToData, err := GetBridgeERC20ToCalldata(l1Token, l2Token, to, amount, minGasLimit, extraData)
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction.Send(context.Background(), l1Client, key, bridgeAddress, big.NewInt(0), bridgeERC20ToData, txFlags)
+}
+
+// ERC20DepositPropose proposes approve + bridgeERC20To to the Safe as a single MultiSend batch, so
+// that the deposit can never be executed without its allowance
+func ERC20DepositPropose(key *keystore.Key, l1Client *ethclient.Client, bridgeAddress common.Address, l1Token common.Address, l2Token common.Address, to common.Address, amount *big.Int, minGasLimit uint32, extraData []byte, safeFlags *safe.Flags, multiSendAddress common.Address) error {
+	erc20Abi, err := ERC20.ERC20MetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+
+	approveData, err := erc20Abi.Pack("approve", bridgeAddress, amount)
+	if err != nil {
+		return err
+	}
+
+	bridgeERC20ToData, err := GetBridgeERC20ToCalldata(l1Token, l2Token, to, amount, minGasLimit, extraData)
+	if err != nil {
+		return err
+	}
+
+	multiSendData, err := safe.GetMultiSendCalldata([]safe.MultiSendTransaction{
+		{Operation: safe.Call, To: l1Token, Value: big.NewInt(0), Data: approveData},
+		{Operation: safe.Call, To: bridgeAddress, Value: big.NewInt(0), Data: bridgeERC20ToData},
+	})
+	if err != nil {
+		return err
+	}
+
+	return safe.CreateSafeProposal(l1Client, key, safeFlags.Address, multiSendAddress, multiSendData, big.NewInt(0), safeFlags.Api, safe.DelegateCall, safeFlags.Nonce)
+}
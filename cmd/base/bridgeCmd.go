@@ -0,0 +1,330 @@
+package base
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/G7DAO/bifrost/cmd/safe"
+	"github.com/G7DAO/bifrost/cmd/transaction"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/cobra"
+)
+
+func CreateBaseBridgeCommand() *cobra.Command {
+	bridgeCmd := &cobra.Command{
+		Use:   "bridge",
+		Short: "Bridge tokens between L1 and an OP Stack chain",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	bridgeCmd.AddCommand(CreateBridgeETHCommand())
+	bridgeCmd.AddCommand(CreateBridgeERC20Command())
+
+	return bridgeCmd
+}
+
+func CreateBridgeETHCommand() *cobra.Command {
+	ethCmd := &cobra.Command{
+		Use:   "eth",
+		Short: "Bridge ETH between L1 and an OP Stack chain",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	ethCmd.AddCommand(CreateBridgeETHDepositCommand())
+
+	return ethCmd
+}
+
+func CreateBridgeERC20Command() *cobra.Command {
+	erc20Cmd := &cobra.Command{
+		Use:   "erc20",
+		Short: "Bridge ERC20 tokens between L1 and an OP Stack chain",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	erc20Cmd.AddCommand(CreateBridgeERC20DepositCommand())
+
+	return erc20Cmd
+}
+
+// parseExtraData decodes the hex --extra-data flag, with or without 0x prefix
+func parseExtraData(extraDataRaw string) ([]byte, error) {
+	if extraDataRaw == "" {
+		return []byte{}, nil
+	}
+
+	extraData, err := hex.DecodeString(strings.TrimPrefix(extraDataRaw, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid extra data: %v", err)
+	}
+	return extraData, nil
+}
+
+func CreateBridgeETHDepositCommand() *cobra.Command {
+	var keyFile, password, l1Rpc, bridgeRaw, toRaw, amountRaw, extraDataRaw string
+	var minGasLimit uint32
+	var bridgeAddress, to common.Address
+	var amount *big.Int
+	var extraData []byte
+	safeFlags := &safe.Flags{}
+	txFlags := &transaction.Flags{}
+
+	depositCmd := &cobra.Command{
+		Use:   "deposit",
+		Short: "Deposit ETH from L1 to an OP Stack chain",
+		Long:  `Deposit ETH from L1 to an OP Stack chain with L1StandardBridge.bridgeETHTo`,
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if l1Rpc == "" {
+				return errors.New("l1-rpc is required")
+			}
+
+			if !common.IsHexAddress(bridgeRaw) {
+				return errors.New("invalid L1StandardBridge address")
+			}
+			bridgeAddress = common.HexToAddress(bridgeRaw)
+
+			if !common.IsHexAddress(toRaw) {
+				return errors.New("invalid recipient address")
+			}
+			to = common.HexToAddress(toRaw)
+
+			if amountRaw == "" {
+				return errors.New("amount is required")
+			}
+			var ok bool
+			amount, ok = new(big.Int).SetString(amountRaw, 10)
+			if !ok || amount.Sign() <= 0 {
+				return errors.New("invalid amount")
+			}
+
+			var extraDataErr error
+			extraData, extraDataErr = parseExtraData(extraDataRaw)
+			if extraDataErr != nil {
+				return extraDataErr
+			}
+
+			if keyFile == "" && !txFlags.Unsigned() {
+				return errors.New("keyfile is required")
+			}
+
+			if safeErr := safeFlags.Parse(l1Rpc); safeErr != nil {
+				return safeErr
+			}
+			if safeFlags.IsSet() && txFlags.Unsigned() {
+				return errors.New("--unsigned-out cannot be used with --safe, the Safe transaction is proposed instead")
+			}
+			if safeFlags.IsSet() && safe.OperationType(safeFlags.Operation) != safe.Call {
+				return errors.New("--safe-operation is not supported, bridgeETHTo is proposed as a Call")
+			}
+
+			if txErr := txFlags.Parse(); txErr != nil {
+				return txErr
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fmt.Println("Bridging", amount.String(), "wei to", to.Hex())
+
+			key, err := txFlags.Key(keyFile, password)
+			if err != nil {
+				return err
+			}
+
+			l1Client, err := ethclient.Dial(l1Rpc)
+			if err != nil {
+				return err
+			}
+
+			if safeFlags.IsSet() {
+				return ETHDepositPropose(key, l1Client, bridgeAddress, to, amount, minGasLimit, extraData, safeFlags)
+			}
+
+			transaction, err := ETHDepositCall(key, l1Client, bridgeAddress, to, amount, minGasLimit, extraData, txFlags)
+			if err != nil {
+				return err
+			}
+			if !txFlags.Unsigned() {
+				fmt.Println("Transaction sent:", transaction.Hash().Hex())
+			}
+
+			return nil
+		},
+	}
+
+	depositCmd.Flags().StringVar(&password, "password", "", "Password to decrypt the keyfile with")
+	depositCmd.Flags().StringVar(&keyFile, "keyfile", "", "Keyfile to sign transaction with")
+	depositCmd.Flags().StringVar(&l1Rpc, "l1-rpc", "", "L1 RPC URL")
+	depositCmd.Flags().StringVar(&bridgeRaw, "bridge", "", "L1StandardBridge address")
+	depositCmd.Flags().StringVar(&toRaw, "to", "", "Recipient address on L2")
+	depositCmd.Flags().StringVar(&amountRaw, "amount", "", "Amount of ETH to deposit, in wei")
+	depositCmd.Flags().Uint32Var(&minGasLimit, "min-gas-limit", DefaultETHMinGasLimit, "Minimum gas limit of the deposit on L2")
+	depositCmd.Flags().StringVar(&extraDataRaw, "extra-data", "", "Hex data forwarded to the recipient with the deposit (optional)")
+	safeFlags.AddFlags(depositCmd)
+	txFlags.AddFlags(depositCmd)
+
+	return depositCmd
+}
+
+func CreateBridgeERC20DepositCommand() *cobra.Command {
+	var keyFile, password, l1Rpc, l2Rpc, bridgeRaw, tokenRaw, l2TokenRaw, toRaw, amountRaw, extraDataRaw, multiSendRaw string
+	var minGasLimit uint32
+	var force bool
+	var bridgeAddress, l1Token, l2Token, to, multiSend common.Address
+	var amount *big.Int
+	var extraData []byte
+	safeFlags := &safe.Flags{}
+	txFlags := &transaction.Flags{}
+
+	depositCmd := &cobra.Command{
+		Use:   "deposit",
+		Short: "Deposit ERC20 tokens from L1 to an OP Stack chain",
+		Long: `Deposit ERC20 tokens from L1 to an OP Stack chain with L1StandardBridge.bridgeERC20To
+
+The L1 token defaults to the remoteToken of the --l2-token OptimismMintableERC20. Unless --force is
+set, the deposit is refused if --l2-token does not support IOptimismMintableERC20 (ERC165), bridges
+another L1 token than --token, or is not minted by the L2StandardBridge. The L1StandardBridge is
+approved for the amount first if needed.`,
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if l1Rpc == "" {
+				return errors.New("l1-rpc is required")
+			}
+
+			if l2Rpc == "" {
+				return errors.New("l2-rpc is required")
+			}
+
+			if !common.IsHexAddress(bridgeRaw) {
+				return errors.New("invalid L1StandardBridge address")
+			}
+			bridgeAddress = common.HexToAddress(bridgeRaw)
+
+			if !common.IsHexAddress(toRaw) {
+				return errors.New("invalid recipient address")
+			}
+			to = common.HexToAddress(toRaw)
+
+			if !common.IsHexAddress(l2TokenRaw) {
+				return errors.New("invalid L2 token address")
+			}
+			l2Token = common.HexToAddress(l2TokenRaw)
+
+			if tokenRaw != "" {
+				if !common.IsHexAddress(tokenRaw) {
+					return errors.New("invalid token address")
+				}
+				l1Token = common.HexToAddress(tokenRaw)
+			}
+
+			l2Client, l2ClientErr := ethclient.Dial(l2Rpc)
+			if l2ClientErr != nil {
+				return l2ClientErr
+			}
+			var tokenErr error
+			l1Token, tokenErr = ResolveTokenPair(l2Client, l1Token, l2Token, force)
+			if tokenErr != nil {
+				return tokenErr
+			}
+
+			if amountRaw == "" {
+				return errors.New("amount is required")
+			}
+			var ok bool
+			amount, ok = new(big.Int).SetString(amountRaw, 10)
+			if !ok || amount.Sign() <= 0 {
+				return errors.New("invalid amount")
+			}
+
+			var extraDataErr error
+			extraData, extraDataErr = parseExtraData(extraDataRaw)
+			if extraDataErr != nil {
+				return extraDataErr
+			}
+
+			if keyFile == "" && !txFlags.Unsigned() {
+				return errors.New("keyfile is required")
+			}
+
+			if safeErr := safeFlags.Parse(l1Rpc); safeErr != nil {
+				return safeErr
+			}
+			if safeFlags.IsSet() && txFlags.Unsigned() {
+				return errors.New("--unsigned-out cannot be used with --safe, the Safe transaction is proposed instead")
+			}
+
+			if safeFlags.IsSet() {
+				if safe.OperationType(safeFlags.Operation) != safe.Call {
+					return errors.New("--safe-operation is not supported, approve and bridgeERC20To are proposed as a MultiSend batch")
+				}
+
+				if !common.IsHexAddress(multiSendRaw) {
+					return errors.New("invalid MultiSend address")
+				}
+				multiSend = common.HexToAddress(multiSendRaw)
+			}
+
+			if txErr := txFlags.Parse(); txErr != nil {
+				return txErr
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fmt.Println("Bridging", amount.String(), "of", l1Token.Hex(), "to", to.Hex(), "as", l2Token.Hex())
+
+			key, err := txFlags.Key(keyFile, password)
+			if err != nil {
+				return err
+			}
+
+			l1Client, err := ethclient.Dial(l1Rpc)
+			if err != nil {
+				return err
+			}
+
+			if safeFlags.IsSet() {
+				return ERC20DepositPropose(key, l1Client, bridgeAddress, l1Token, l2Token, to, amount, minGasLimit, extraData, safeFlags, multiSend)
+			}
+
+			transaction, err := ERC20DepositCall(key, l1Client, bridgeAddress, l1Token, l2Token, to, amount, minGasLimit, extraData, txFlags)
+			if err != nil {
+				return err
+			}
+			if !txFlags.Unsigned() {
+				fmt.Println("Transaction sent:", transaction.Hash().Hex())
+			}
+
+			return nil
+		},
+	}
+
+	depositCmd.Flags().StringVar(&password, "password", "", "Password to decrypt the keyfile with")
+	depositCmd.Flags().StringVar(&keyFile, "keyfile", "", "Keyfile to sign transaction with")
+	depositCmd.Flags().StringVar(&l1Rpc, "l1-rpc", "", "L1 RPC URL")
+	depositCmd.Flags().StringVar(&l2Rpc, "l2-rpc", "", "L2 RPC URL, to look up the remote token of --l2-token")
+	depositCmd.Flags().StringVar(&bridgeRaw, "bridge", "", "L1StandardBridge address")
+	depositCmd.Flags().StringVar(&tokenRaw, "token", "", "L1 token address (optional, defaults to the remote token of --l2-token)")
+	depositCmd.Flags().StringVar(&l2TokenRaw, "l2-token", "", "OptimismMintableERC20 token on L2")
+	depositCmd.Flags().StringVar(&toRaw, "to", "", "Recipient address on L2")
+	depositCmd.Flags().StringVar(&amountRaw, "amount", "", "Amount of tokens to deposit")
+	depositCmd.Flags().Uint32Var(&minGasLimit, "min-gas-limit", DefaultERC20MinGasLimit, "Minimum gas limit of the deposit on L2")
+	depositCmd.Flags().StringVar(&extraDataRaw, "extra-data", "", "Hex data forwarded to the recipient with the deposit (optional)")
+	depositCmd.Flags().BoolVar(&force, "force", false, "Bridge even if the token pair fails validation on L2")
+	safeFlags.AddFlags(depositCmd)
+	txFlags.AddFlags(depositCmd)
+	depositCmd.Flags().StringVar(&multiSendRaw, "safe-multisend", safe.MultiSendCallOnlyAddress, "MultiSendCallOnly contract used to batch Safe proposals")
+
+	return depositCmd
+}
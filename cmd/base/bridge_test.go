@@ -0,0 +1,31 @@
+package base
+
+import (
+	"testing"
+
+	"github.com/G7DAO/bifrost/bindings/OptimismMintableERC20"
+)
+
+func TestMintableERC20InterfaceIDs(t *testing.T) {
+	tokenAbi, err := OptimismMintableERC20.OptimismMintableERC20MetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	interfaceID := func(methods ...string) [4]byte {
+		var id [4]byte
+		for _, method := range methods {
+			for i, b := range tokenAbi.Methods[method].ID {
+				id[i] ^= b
+			}
+		}
+		return id
+	}
+
+	if id := interfaceID("remoteToken", "bridge", "mint", "burn"); id != OptimismMintableERC20InterfaceID {
+		t.Errorf("expected IOptimismMintableERC20 interface ID %x, got %x", OptimismMintableERC20InterfaceID, id)
+	}
+	if id := interfaceID("l1Token", "mint", "burn"); id != LegacyMintableERC20InterfaceID {
+		t.Errorf("expected ILegacyMintableERC20 interface ID %x, got %x", LegacyMintableERC20InterfaceID, id)
+	}
+}
@@ -0,0 +1,218 @@
+package base
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/G7DAO/bifrost/bindings/L1StandardBridge"
+	"github.com/G7DAO/bifrost/bindings/OptimismPortal"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// DepositTxType is the EIP-2718 type of OP Stack deposit transactions
+// Source: https://specs.optimism.io/protocol/deposits.html#the-deposited-transaction-type
+const DepositTxType byte = 0x7E
+
+const (
+	DepositStatusPending   = "pending"
+	DepositStatusSucceeded = "succeeded"
+	DepositStatusFailed    = "failed"
+)
+
+// Source hash domains of deposits made through OptimismPortal, and of the L1 attributes deposits of
+// the sequencer
+const (
+	userDepositSourceDomain   = 0
+	l1InfoDepositSourceDomain = 1
+)
+
+// Deposit is a deposit transaction emitted by OptimismPortal.TransactionDeposited
+type Deposit struct {
+	From       common.Address
+	To         common.Address
+	Mint       *big.Int
+	Value      *big.Int
+	GasLimit   uint64
+	IsCreation bool
+	Data       []byte
+	SourceHash common.Hash
+	L2TxHash   common.Hash
+	// ETHBridge or ERC20Bridge is set when the deposit relays a L1StandardBridge transfer
+	ETHBridge   *L1StandardBridge.L1StandardBridgeETHBridgeInitiated
+	ERC20Bridge *L1StandardBridge.L1StandardBridgeERC20BridgeInitiated
+}
+
+// depositTx is the RLP payload of a deposit transaction
+type depositTx struct {
+	SourceHash          common.Hash
+	From                common.Address
+	To                  *common.Address `rlp:"nil"`
+	Mint                *big.Int        `rlp:"nil"`
+	Value               *big.Int
+	Gas                 uint64
+	IsSystemTransaction bool
+	Data                []byte
+}
+
+// UserDepositSourceHash returns the source hash of the deposit emitted by the log at logIndex of
+// the L1 block
+// Source: https://specs.optimism.io/protocol/deposits.html#source-hash-computation
+func UserDepositSourceHash(l1BlockHash common.Hash, logIndex uint) common.Hash {
+	return depositSourceHash(userDepositSourceDomain, l1BlockHash, uint64(logIndex))
+}
+
+// depositSourceHash returns keccak256(domain ‖ keccak256(l1BlockHash ‖ index)), the source hash of
+// user deposits and of L1 attributes deposits
+func depositSourceHash(domain int64, l1BlockHash common.Hash, index uint64) common.Hash {
+	depositID := crypto.Keccak256Hash(l1BlockHash.Bytes(), common.BigToHash(new(big.Int).SetUint64(index)).Bytes())
+	return crypto.Keccak256Hash(common.BigToHash(big.NewInt(domain)).Bytes(), depositID.Bytes())
+}
+
+// DepositTxHash returns the hash of the deposit transaction on L2
+func DepositTxHash(deposit *Deposit) (common.Hash, error) {
+	tx := depositTx{
+		SourceHash: deposit.SourceHash,
+		From:       deposit.From,
+		Value:      deposit.Value,
+		Gas:        deposit.GasLimit,
+		Data:       deposit.Data,
+	}
+	if !deposit.IsCreation {
+		to := deposit.To
+		tx.To = &to
+	}
+	if deposit.Mint.Sign() != 0 {
+		tx.Mint = deposit.Mint
+	}
+
+	encoded, err := rlp.EncodeToBytes(&tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash([]byte{DepositTxType}, encoded), nil
+}
+
+// ParseOpaqueData decodes the opaque data of a version 0 TransactionDeposited event, packed as
+// mint ‖ value ‖ gasLimit ‖ isCreation ‖ data
+func ParseOpaqueData(deposit *Deposit, opaqueData []byte) error {
+	if len(opaqueData) < 73 {
+		return fmt.Errorf("invalid opaque data length %d", len(opaqueData))
+	}
+
+	deposit.Mint = new(big.Int).SetBytes(opaqueData[0:32])
+	deposit.Value = new(big.Int).SetBytes(opaqueData[32:64])
+	deposit.GasLimit = new(big.Int).SetBytes(opaqueData[64:72]).Uint64()
+	deposit.IsCreation = opaqueData[72] != 0
+	deposit.Data = opaqueData[73:]
+
+	return nil
+}
+
+// GetDepositsFromReceipt returns the deposits of the L1 transaction, in log order. If portal is set,
+// only deposits of that OptimismPortal are returned.
+func GetDepositsFromReceipt(receipt *types.Receipt, portal common.Address) ([]*Deposit, error) {
+	portalFilterer, err := OptimismPortal.NewOptimismPortalFilterer(common.Address{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	bridgeFilterer, err := L1StandardBridge.NewL1StandardBridgeFilterer(common.Address{}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	portalAbi, err := OptimismPortal.OptimismPortalMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	bridgeAbi, err := L1StandardBridge.L1StandardBridgeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	transactionDepositedID := portalAbi.Events["TransactionDeposited"].ID
+	ethBridgeInitiatedID := bridgeAbi.Events["ETHBridgeInitiated"].ID
+	erc20BridgeInitiatedID := bridgeAbi.Events["ERC20BridgeInitiated"].ID
+
+	// The bridge events are emitted before the L1CrossDomainMessenger sends the message through the
+	// portal, so they belong to the next deposit
+	var ethBridge *L1StandardBridge.L1StandardBridgeETHBridgeInitiated
+	var erc20Bridge *L1StandardBridge.L1StandardBridgeERC20BridgeInitiated
+	var deposits []*Deposit
+	for _, log := range receipt.Logs {
+		if len(log.Topics) == 0 {
+			continue
+		}
+
+		switch log.Topics[0] {
+		case ethBridgeInitiatedID:
+			ethBridge, err = bridgeFilterer.ParseETHBridgeInitiated(*log)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse ETHBridgeInitiated log %d: %v", log.Index, err)
+			}
+		case erc20BridgeInitiatedID:
+			erc20Bridge, err = bridgeFilterer.ParseERC20BridgeInitiated(*log)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse ERC20BridgeInitiated log %d: %v", log.Index, err)
+			}
+		case transactionDepositedID:
+			if portal != (common.Address{}) && log.Address != portal {
+				continue
+			}
+
+			deposited, parseErr := portalFilterer.ParseTransactionDeposited(*log)
+			if parseErr != nil {
+				return nil, fmt.Errorf("failed to parse TransactionDeposited log %d: %v", log.Index, parseErr)
+			}
+			if deposited.Version.Sign() != 0 {
+				return nil, fmt.Errorf("unsupported deposit version %s in log %d", deposited.Version.String(), log.Index)
+			}
+
+			deposit := &Deposit{
+				From:        deposited.From,
+				To:          deposited.To,
+				SourceHash:  UserDepositSourceHash(log.BlockHash, log.Index),
+				ETHBridge:   ethBridge,
+				ERC20Bridge: erc20Bridge,
+			}
+			if opaqueErr := ParseOpaqueData(deposit, deposited.OpaqueData); opaqueErr != nil {
+				return nil, fmt.Errorf("failed to parse TransactionDeposited log %d: %v", log.Index, opaqueErr)
+			}
+
+			deposit.L2TxHash, err = DepositTxHash(deposit)
+			if err != nil {
+				return nil, err
+			}
+
+			deposits = append(deposits, deposit)
+			ethBridge, erc20Bridge = nil, nil
+		}
+	}
+
+	if len(deposits) == 0 {
+		return nil, errors.New("no TransactionDeposited event found in the transaction")
+	}
+	return deposits, nil
+}
+
+// GetDepositStatus returns the status of the deposit on L2, along with its L2 receipt once it is
+// included
+func GetDepositStatus(l2Client *ethclient.Client, deposit *Deposit) (string, *types.Receipt, error) {
+	receipt, err := l2Client.TransactionReceipt(context.Background(), deposit.L2TxHash)
+	if errors.Is(err, ethereum.NotFound) {
+		return DepositStatusPending, nil, nil
+	}
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to fetch L2 receipt of deposit %s: %v", deposit.L2TxHash.Hex(), err)
+	}
+
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return DepositStatusFailed, receipt, nil
+	}
+	return DepositStatusSucceeded, receipt, nil
+}
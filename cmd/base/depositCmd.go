@@ -0,0 +1,127 @@
+package base
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/cobra"
+)
+
+func CreateDepositCommand() *cobra.Command {
+	depositCmd := &cobra.Command{
+		Use:   "deposit",
+		Short: "Track deposits from L1 to an OP Stack chain",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	depositCmd.AddCommand(CreateDepositStatusCommand())
+
+	return depositCmd
+}
+
+func CreateDepositStatusCommand() *cobra.Command {
+	var l1Rpc, l2Rpc, portalRaw string
+	var portal common.Address
+	var txHash common.Hash
+
+	statusCmd := &cobra.Command{
+		Use:   "status <l1-tx-hash>",
+		Short: "Show the L2 status of the deposits of an L1 transaction",
+		Long: `Show the L2 status of the deposits of an L1 transaction
+
+The L2 deposit transaction hash is derived from each OptimismPortal.TransactionDeposited event of the
+L1 transaction, and its L2 receipt is checked. Deposits made through L1StandardBridge show the
+bridged amount.`,
+		Args: cobra.ExactArgs(1),
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if len(common.FromHex(args[0])) != common.HashLength {
+				return errors.New("invalid L1 transaction hash")
+			}
+			txHash = common.HexToHash(args[0])
+
+			if l1Rpc == "" {
+				return errors.New("l1-rpc is required")
+			}
+
+			if l2Rpc == "" {
+				return errors.New("l2-rpc is required")
+			}
+
+			if portalRaw != "" {
+				if !common.IsHexAddress(portalRaw) {
+					return errors.New("invalid OptimismPortal address")
+				}
+				portal = common.HexToAddress(portalRaw)
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			l1Client, err := ethclient.Dial(l1Rpc)
+			if err != nil {
+				return err
+			}
+
+			l2Client, err := ethclient.Dial(l2Rpc)
+			if err != nil {
+				return err
+			}
+
+			receipt, err := l1Client.TransactionReceipt(context.Background(), txHash)
+			if err != nil {
+				return fmt.Errorf("failed to fetch receipt of %s: %v", txHash.Hex(), err)
+			}
+			if receipt.Status != types.ReceiptStatusSuccessful {
+				return fmt.Errorf("transaction %s reverted", txHash.Hex())
+			}
+
+			deposits, err := GetDepositsFromReceipt(receipt, portal)
+			if err != nil {
+				return err
+			}
+
+			for i, deposit := range deposits {
+				status, l2Receipt, statusErr := GetDepositStatus(l2Client, deposit)
+				if statusErr != nil {
+					return statusErr
+				}
+
+				fmt.Printf("Deposit %d: %s\n", i, deposit.L2TxHash.Hex())
+				fmt.Println("  From:", deposit.From.Hex())
+				if deposit.IsCreation {
+					fmt.Println("  To: contract creation")
+				} else {
+					fmt.Println("  To:", deposit.To.Hex())
+				}
+				fmt.Println("  Mint:", deposit.Mint.String())
+				fmt.Println("  Value:", deposit.Value.String())
+				fmt.Println("  Gas limit:", deposit.GasLimit)
+				if deposit.ETHBridge != nil {
+					fmt.Println("  Bridged:", deposit.ETHBridge.Amount.String(), "wei from", deposit.ETHBridge.From.Hex(), "to", deposit.ETHBridge.To.Hex())
+				}
+				if deposit.ERC20Bridge != nil {
+					fmt.Println("  Bridged:", deposit.ERC20Bridge.Amount.String(), "of", deposit.ERC20Bridge.LocalToken.Hex(), "as", deposit.ERC20Bridge.RemoteToken.Hex(), "from", deposit.ERC20Bridge.From.Hex(), "to", deposit.ERC20Bridge.To.Hex())
+				}
+				fmt.Println("  Status:", status)
+				if l2Receipt != nil {
+					fmt.Println("  L2 block:", l2Receipt.BlockNumber.String())
+				}
+			}
+
+			return nil
+		},
+	}
+
+	statusCmd.Flags().StringVar(&l1Rpc, "l1-rpc", "", "L1 RPC URL")
+	statusCmd.Flags().StringVar(&l2Rpc, "l2-rpc", "", "L2 RPC URL")
+	statusCmd.Flags().StringVar(&portalRaw, "portal", "", "Only track deposits of this OptimismPortal (optional)")
+
+	return statusCmd
+}
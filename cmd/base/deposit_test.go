@@ -0,0 +1,177 @@
+package base
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/G7DAO/bifrost/bindings/L1StandardBridge"
+	"github.com/G7DAO/bifrost/bindings/OptimismPortal"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func opaqueData(mint *big.Int, value *big.Int, gasLimit uint64, isCreation bool, data []byte) []byte {
+	var packed []byte
+	packed = append(packed, common.BigToHash(mint).Bytes()...)
+	packed = append(packed, common.BigToHash(value).Bytes()...)
+	packed = append(packed, common.LeftPadBytes(new(big.Int).SetUint64(gasLimit).Bytes(), 8)...)
+	if isCreation {
+		packed = append(packed, 1)
+	} else {
+		packed = append(packed, 0)
+	}
+	return append(packed, data...)
+}
+
+func TestParseOpaqueData(t *testing.T) {
+	deposit := &Deposit{}
+	err := ParseOpaqueData(deposit, opaqueData(big.NewInt(100), big.NewInt(50), 200_000, false, []byte{0xde, 0xad}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deposit.Mint.Int64() != 100 || deposit.Value.Int64() != 50 || deposit.GasLimit != 200_000 || deposit.IsCreation || common.Bytes2Hex(deposit.Data) != "dead" {
+		t.Errorf("unexpected deposit %+v", deposit)
+	}
+
+	if err := ParseOpaqueData(deposit, make([]byte, 72)); err == nil {
+		t.Error("expected an error on short opaque data")
+	}
+}
+
+func TestDepositSourceHash(t *testing.T) {
+	// Vector of TestL1InfoDepositSource in op-node, which shares the derivation of user deposits
+	// Source: https://github.com/ethereum-optimism/optimism/blob/develop/op-node/rollup/derive/deposit_source_test.go
+	l1BlockHash := common.HexToHash("0xc00e5d67c2755389aded7d8b151cbd5bcdf7ed275ad5e028b664880fc7581c77")
+	if sourceHash := depositSourceHash(l1InfoDepositSourceDomain, l1BlockHash, 4); sourceHash != common.HexToHash("0x0586c503340591999b8b38bc9834bb16aec7d5bc00eb5587ab139c9ddab81977") {
+		t.Errorf("unexpected L1 info source hash %s", sourceHash.Hex())
+	}
+
+	if UserDepositSourceHash(l1BlockHash, 4) != depositSourceHash(userDepositSourceDomain, l1BlockHash, 4) {
+		t.Error("user deposits must use the user deposit domain")
+	}
+	if UserDepositSourceHash(l1BlockHash, 4) == UserDepositSourceHash(l1BlockHash, 5) {
+		t.Error("deposits of different logs must have different source hashes")
+	}
+}
+
+func TestDepositTxHash(t *testing.T) {
+	sourceHash := common.HexToHash("0x0586c503340591999b8b38bc9834bb16aec7d5bc00eb5587ab139c9ddab81977")
+	from := common.HexToAddress("0x3154Cf16ccdb4C6d922629664174b904d80F2C35")
+	to := common.HexToAddress("0x4200000000000000000000000000000000000007")
+
+	testCases := []struct {
+		name    string
+		deposit *Deposit
+		// RLP of [sourceHash, from, to, mint, value, gas, isSystemTransaction, data], written out by
+		// hand: a nil to or a zero mint is the empty string 0x80
+		expected string
+	}{
+		{
+			name:    "call without mint",
+			deposit: &Deposit{SourceHash: sourceHash, From: from, To: to, Mint: big.NewInt(0), Value: big.NewInt(0), GasLimit: 100_000},
+			expected: "f853" +
+				"a0" + "0586c503340591999b8b38bc9834bb16aec7d5bc00eb5587ab139c9ddab81977" +
+				"94" + "3154cf16ccdb4c6d922629664174b904d80f2c35" +
+				"94" + "4200000000000000000000000000000000000007" +
+				"80" + // mint
+				"80" + // value
+				"830186a0" + // gas
+				"80" + // isSystemTransaction
+				"80", // data
+		},
+		{
+			name:    "contract creation with mint",
+			deposit: &Deposit{SourceHash: sourceHash, From: from, To: to, Mint: big.NewInt(1_000_000_000_000_000), Value: big.NewInt(1_000_000_000_000_000), GasLimit: 100_000, IsCreation: true, Data: []byte{0x60, 0x80}},
+			expected: "f84f" +
+				"a0" + "0586c503340591999b8b38bc9834bb16aec7d5bc00eb5587ab139c9ddab81977" +
+				"94" + "3154cf16ccdb4c6d922629664174b904d80f2c35" +
+				"80" + // to
+				"87038d7ea4c68000" + // mint
+				"87038d7ea4c68000" + // value
+				"830186a0" + // gas
+				"80" + // isSystemTransaction
+				"826080", // data
+		},
+	}
+	for _, testCase := range testCases {
+		hash, err := DepositTxHash(testCase.deposit)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", testCase.name, err)
+		}
+		expected := crypto.Keccak256Hash([]byte{DepositTxType}, common.FromHex(testCase.expected))
+		if hash != expected {
+			t.Errorf("%s: expected L2 transaction hash %s, got %s", testCase.name, expected.Hex(), hash.Hex())
+		}
+	}
+}
+
+func TestGetDepositsFromReceipt(t *testing.T) {
+	portalAbi, err := OptimismPortal.OptimismPortalMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bridgeAbi, err := L1StandardBridge.L1StandardBridgeMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	portal := common.HexToAddress("0x49f53e41452C74589E85cA1677426Ba426459e85")
+	bridge := common.HexToAddress("0xfd0Bf71F60660E2f608ed56e1659C450eB113120")
+	messenger := common.HexToAddress("0xC34855F4De64F1840e5686e64278da901e261f20")
+	sender := common.HexToAddress("0x3154Cf16ccdb4C6d922629664174b904d80F2C35")
+	blockHash := common.HexToHash("0x01")
+	amount := big.NewInt(1_000_000_000_000_000)
+
+	bridgeData, err := bridgeAbi.Events["ETHBridgeInitiated"].Inputs.NonIndexed().Pack(amount, []byte{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	depositedData, err := portalAbi.Events["TransactionDeposited"].Inputs.NonIndexed().Pack(opaqueData(amount, amount, 287_000, false, []byte{0xd7}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bridgeLog := &types.Log{
+		Address:   bridge,
+		Topics:    []common.Hash{bridgeAbi.Events["ETHBridgeInitiated"].ID, common.BytesToHash(sender.Bytes()), common.BytesToHash(sender.Bytes())},
+		Data:      bridgeData,
+		BlockHash: blockHash,
+		Index:     3,
+	}
+	depositedLog := func(index uint) *types.Log {
+		return &types.Log{
+			Address:   portal,
+			Topics:    []common.Hash{portalAbi.Events["TransactionDeposited"].ID, common.BytesToHash(messenger.Bytes()), common.BytesToHash(sender.Bytes()), {}},
+			Data:      depositedData,
+			BlockHash: blockHash,
+			Index:     index,
+		}
+	}
+
+	receipt := &types.Receipt{Logs: []*types.Log{bridgeLog, depositedLog(4), depositedLog(5)}}
+	deposits, err := GetDepositsFromReceipt(receipt, portal)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deposits) != 2 {
+		t.Fatalf("expected 2 deposits, got %d", len(deposits))
+	}
+
+	if deposits[0].ETHBridge == nil || deposits[0].ETHBridge.Amount.Cmp(amount) != 0 || deposits[0].ETHBridge.To != sender {
+		t.Errorf("expected the ETHBridgeInitiated event on the first deposit, got %+v", deposits[0].ETHBridge)
+	}
+	if deposits[1].ETHBridge != nil {
+		t.Error("expected no bridge event on the second deposit")
+	}
+	if deposits[0].From != messenger || deposits[0].To != sender || deposits[0].Mint.Cmp(amount) != 0 {
+		t.Errorf("unexpected deposit %+v", deposits[0])
+	}
+	if deposits[0].SourceHash != UserDepositSourceHash(blockHash, 4) || deposits[0].L2TxHash == deposits[1].L2TxHash {
+		t.Error("expected the source hash and L2 transaction hash to depend on the log index")
+	}
+
+	if _, err := GetDepositsFromReceipt(receipt, bridge); err == nil {
+		t.Error("expected an error without deposits of the portal")
+	}
+}
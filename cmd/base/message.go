@@ -0,0 +1,243 @@
+package base
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/G7DAO/bifrost/bindings/L1CrossDomainMessenger"
+	"github.com/G7DAO/bifrost/cmd/safe"
+	"github.com/G7DAO/bifrost/cmd/transaction"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// L2CrossDomainMessengerAddress is the predeploy relaying L1CrossDomainMessenger messages on L2. It
+// shares the CrossDomainMessenger ABI of L1CrossDomainMessenger.
+var L2CrossDomainMessengerAddress = common.HexToAddress("0x4200000000000000000000000000000000000007")
+
+// Overheads of CrossDomainMessenger.baseGas
+// Source: https://github.com/ethereum-optimism/optimism/blob/develop/packages/contracts-bedrock/src/universal/CrossDomainMessenger.sol
+const (
+	relayConstantOverhead            uint64 = 200_000
+	minGasDynamicOverheadNumerator   uint64 = 64
+	minGasDynamicOverheadDenominator uint64 = 63
+	minGasCalldataOverhead           uint64 = 16
+	relayCallOverhead                uint64 = 40_000
+	relayReservedGas                 uint64 = 40_000
+	relayGasCheckBuffer              uint64 = 5_000
+	encodingOverhead                 uint64 = 260
+	floorCalldataOverhead            uint64 = 40
+	txBaseGas                        uint64 = 21_000
+)
+
+// MessageMinGasLimitBuffer is the headroom, in percent, added to the L2 gas estimate of the target
+// call to get the minimum gas limit of a message
+const MessageMinGasLimitBuffer = 20
+
+const (
+	DefaultRelayPollInterval = 10 * time.Second
+	DefaultRelayTimeout      = 10 * time.Minute
+)
+
+// SentMessage is a message sent through a CrossDomainMessenger
+type SentMessage struct {
+	Nonce       *big.Int
+	Sender      common.Address
+	Target      common.Address
+	Value       *big.Int
+	MinGasLimit *big.Int
+	Message     []byte
+	Hash        common.Hash
+}
+
+// MessengerBaseGas returns the gas limit L1CrossDomainMessenger gives the deposit relaying a message:
+// the minimum gas limit of the target call, and the overhead of relayMessage on L2
+func MessengerBaseGas(messageLength int, minGasLimit uint32) uint64 {
+	executionGas := relayConstantOverhead + relayCallOverhead + relayReservedGas + relayGasCheckBuffer + (uint64(minGasLimit)*minGasDynamicOverheadNumerator)/minGasDynamicOverheadDenominator
+	totalMessageSize := uint64(messageLength) + encodingOverhead
+
+	return txBaseGas + max(executionGas+totalMessageSize*minGasCalldataOverhead, totalMessageSize*floorCalldataOverhead)
+}
+
+// EstimateMessageMinGasLimit estimates the gas of the target call on L2, made by
+// L2CrossDomainMessenger, with MessageMinGasLimitBuffer headroom
+func EstimateMessageMinGasLimit(l2Client *ethclient.Client, target common.Address, value *big.Int, message []byte) (uint32, error) {
+	call := ethereum.CallMsg{
+		From:  L2CrossDomainMessengerAddress,
+		To:    &target,
+		Value: value,
+		Data:  message,
+	}
+
+	gas, err := l2Client.EstimateGas(context.Background(), call)
+	if err != nil && value.Sign() > 0 {
+		// The messenger only holds the value of a message while relaying it
+		call.Value = nil
+		gas, err = l2Client.EstimateGas(context.Background(), call)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to estimate the call to %s on L2: %v", target.Hex(), err)
+	}
+
+	gas = gas * (100 + MessageMinGasLimitBuffer) / 100
+	if gas > math.MaxUint32 {
+		return 0, fmt.Errorf("estimated gas %d of the call to %s on L2 exceeds the maximum minimum gas limit", gas, target.Hex())
+	}
+	return uint32(gas), nil
+}
+
+// HashCrossDomainMessage returns the hash under which L2CrossDomainMessenger relays a version 1 message
+// Source: https://github.com/ethereum-optimism/optimism/blob/develop/packages/contracts-bedrock/src/libraries/Hashing.sol
+func HashCrossDomainMessage(message *SentMessage) (common.Hash, error) {
+	if version := new(big.Int).Rsh(message.Nonce, 240); version.Cmp(big.NewInt(1)) != 0 {
+		return common.Hash{}, fmt.Errorf("unsupported message version %s", version.String())
+	}
+
+	messengerAbi, err := L1CrossDomainMessenger.L1CrossDomainMessengerMetaData.GetAbi()
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	relayMessageData, err := messengerAbi.Pack("relayMessage", message.Nonce, message.Sender, message.Target, message.Value, message.MinGasLimit, message.Message)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash(relayMessageData), nil
+}
+
+// GetSentMessagesFromReceipt returns the messages sent through the messenger by the transaction, in
+// log order
+func GetSentMessagesFromReceipt(receipt *types.Receipt, messenger common.Address) ([]*SentMessage, error) {
+	messengerFilterer, err := L1CrossDomainMessenger.NewL1CrossDomainMessengerFilterer(messenger, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	messengerAbi, err := L1CrossDomainMessenger.L1CrossDomainMessengerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	sentMessageID := messengerAbi.Events["SentMessage"].ID
+	sentMessageExtension1ID := messengerAbi.Events["SentMessageExtension1"].ID
+
+	var messages []*SentMessage
+	for _, log := range receipt.Logs {
+		if log.Address != messenger || len(log.Topics) == 0 {
+			continue
+		}
+
+		switch log.Topics[0] {
+		case sentMessageID:
+			sent, parseErr := messengerFilterer.ParseSentMessage(*log)
+			if parseErr != nil {
+				return nil, fmt.Errorf("failed to parse SentMessage log %d: %v", log.Index, parseErr)
+			}
+			messages = append(messages, &SentMessage{
+				Nonce:       sent.MessageNonce,
+				Sender:      sent.Sender,
+				Target:      sent.Target,
+				Value:       big.NewInt(0),
+				MinGasLimit: sent.GasLimit,
+				Message:     sent.Message,
+			})
+		case sentMessageExtension1ID:
+			// SentMessageExtension1 carries the value of the SentMessage emitted right before it
+			if len(messages) == 0 {
+				return nil, fmt.Errorf("SentMessageExtension1 log %d without SentMessage", log.Index)
+			}
+			extension, parseErr := messengerFilterer.ParseSentMessageExtension1(*log)
+			if parseErr != nil {
+				return nil, fmt.Errorf("failed to parse SentMessageExtension1 log %d: %v", log.Index, parseErr)
+			}
+			messages[len(messages)-1].Value = extension.Value
+		}
+	}
+
+	if len(messages) == 0 {
+		return nil, errors.New("no SentMessage event found in the transaction")
+	}
+
+	for _, message := range messages {
+		message.Hash, err = HashCrossDomainMessage(message)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return messages, nil
+}
+
+func GetSendMessageCalldata(target common.Address, message []byte, minGasLimit uint32) ([]byte, error) {
+	messengerAbi, err := L1CrossDomainMessenger.L1CrossDomainMessengerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	return messengerAbi.Pack("sendMessage", target, message, minGasLimit)
+}
+
+func SendMessageCall(key *keystore.Key, l1Client *ethclient.Client, messenger common.Address, target common.Address, value *big.Int, message []byte, minGasLimit uint32, txFlags *transaction.Flags) (*types.Transaction, error) {
+	sendMessageData, err := GetSendMessageCalldata(target, message, minGasLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction.Send(context.Background(), l1Client, key, messenger, value, sendMessageData, txFlags)
+}
+
+func SendMessagePropose(key *keystore.Key, l1Client *ethclient.Client, messenger common.Address, target common.Address, value *big.Int, message []byte, minGasLimit uint32, safeFlags *safe.Flags) error {
+	sendMessageData, err := GetSendMessageCalldata(target, message, minGasLimit)
+	if err != nil {
+		return err
+	}
+
+	return safe.CreateSafeProposal(l1Client, key, safeFlags.Address, messenger, sendMessageData, value, safeFlags.Api, safe.OperationType(safeFlags.Operation), safeFlags.Nonce)
+}
+
+// WaitForRelayedMessage polls L2CrossDomainMessenger from fromBlock until RelayedMessage or
+// FailedRelayedMessage is emitted for the message hash. It returns the log and whether the relay
+// succeeded.
+func WaitForRelayedMessage(ctx context.Context, l2Client *ethclient.Client, messageHash common.Hash, fromBlock uint64, pollInterval time.Duration, timeout time.Duration) (*types.Log, bool, error) {
+	messengerAbi, err := L1CrossDomainMessenger.L1CrossDomainMessengerMetaData.GetAbi()
+	if err != nil {
+		return nil, false, err
+	}
+	relayedMessageID := messengerAbi.Events["RelayedMessage"].ID
+	failedRelayedMessageID := messengerAbi.Events["FailedRelayedMessage"].ID
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		latest, headErr := l2Client.BlockNumber(ctx)
+		if headErr == nil && latest >= fromBlock {
+			logs, filterErr := l2Client.FilterLogs(ctx, ethereum.FilterQuery{
+				FromBlock: new(big.Int).SetUint64(fromBlock),
+				ToBlock:   new(big.Int).SetUint64(latest),
+				Addresses: []common.Address{L2CrossDomainMessengerAddress},
+				Topics:    [][]common.Hash{{relayedMessageID, failedRelayedMessageID}, {messageHash}},
+			})
+			if filterErr != nil {
+				return nil, false, fmt.Errorf("failed to filter L2CrossDomainMessenger logs: %v", filterErr)
+			}
+			if len(logs) > 0 {
+				log := logs[len(logs)-1]
+				return &log, log.Topics[0] == relayedMessageID, nil
+			}
+			fromBlock = latest + 1
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, false, fmt.Errorf("timed out waiting for message %s to be relayed on L2", messageHash.Hex())
+		case <-time.After(pollInterval):
+		}
+	}
+}
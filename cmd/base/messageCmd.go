@@ -0,0 +1,178 @@
+package base
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/G7DAO/bifrost/cmd/safe"
+	"github.com/G7DAO/bifrost/cmd/transaction"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/cobra"
+)
+
+func CreateMessageCommand() *cobra.Command {
+	var keyFile, password, l1Rpc, l2Rpc, messengerRaw, toRaw, amountRaw, l2CalldataRaw string
+	var minGasLimit uint32
+	var pollInterval, timeout time.Duration
+	var messenger, to common.Address
+	var amount *big.Int
+	var l2Calldata []byte
+	safeFlags := &safe.Flags{}
+	txFlags := &transaction.Flags{}
+
+	messageCmd := &cobra.Command{
+		Use:   "message",
+		Short: "Send an arbitrary message from L1 to an OP Stack chain",
+		Long: `Send an arbitrary message from L1 to an OP Stack chain with L1CrossDomainMessenger.sendMessage
+
+Unless --min-gas-limit is set, the minimum gas limit of the message is estimated from the call of
+--to by L2CrossDomainMessenger on L2. Once sent, the command waits for the message to be relayed on
+L2 and reports its hash, until --timeout.`,
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if l1Rpc == "" {
+				return errors.New("l1-rpc is required")
+			}
+
+			if l2Rpc == "" {
+				return errors.New("l2-rpc is required")
+			}
+
+			if !common.IsHexAddress(messengerRaw) {
+				return errors.New("invalid L1CrossDomainMessenger address")
+			}
+			messenger = common.HexToAddress(messengerRaw)
+
+			if !common.IsHexAddress(toRaw) {
+				return errors.New("invalid recipient address")
+			}
+			to = common.HexToAddress(toRaw)
+
+			amount = big.NewInt(0)
+			if amountRaw != "" {
+				var ok bool
+				amount, ok = new(big.Int).SetString(amountRaw, 10)
+				if !ok || amount.Sign() < 0 {
+					return errors.New("invalid amount")
+				}
+			}
+
+			var calldataErr error
+			l2Calldata, calldataErr = parseExtraData(l2CalldataRaw)
+			if calldataErr != nil {
+				return calldataErr
+			}
+
+			if keyFile == "" && !txFlags.Unsigned() {
+				return errors.New("keyfile is required")
+			}
+
+			if safeErr := safeFlags.Parse(l1Rpc); safeErr != nil {
+				return safeErr
+			}
+			if safeFlags.IsSet() && txFlags.Unsigned() {
+				return errors.New("--unsigned-out cannot be used with --safe, the Safe transaction is proposed instead")
+			}
+			if safeFlags.IsSet() && safe.OperationType(safeFlags.Operation) != safe.Call {
+				return errors.New("--safe-operation is not supported, sendMessage is proposed as a Call")
+			}
+
+			if txErr := txFlags.Parse(); txErr != nil {
+				return txErr
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := txFlags.Key(keyFile, password)
+			if err != nil {
+				return err
+			}
+
+			l1Client, err := ethclient.Dial(l1Rpc)
+			if err != nil {
+				return err
+			}
+
+			l2Client, err := ethclient.Dial(l2Rpc)
+			if err != nil {
+				return err
+			}
+
+			if minGasLimit == 0 {
+				minGasLimit, err = EstimateMessageMinGasLimit(l2Client, to, amount, l2Calldata)
+				if err != nil {
+					return err
+				}
+				fmt.Println("Estimated minimum gas limit:", minGasLimit)
+			}
+			fmt.Println("Sending message to", to.Hex(), "with", amount.String(), "wei, relayed with up to", MessengerBaseGas(len(l2Calldata), minGasLimit), "gas on L2")
+
+			if safeFlags.IsSet() {
+				return SendMessagePropose(key, l1Client, messenger, to, amount, l2Calldata, minGasLimit, safeFlags)
+			}
+
+			l2Head, err := l2Client.BlockNumber(context.Background())
+			if err != nil {
+				return fmt.Errorf("failed to fetch L2 block number: %v", err)
+			}
+
+			tx, err := SendMessageCall(key, l1Client, messenger, to, amount, l2Calldata, minGasLimit, txFlags)
+			if err != nil {
+				return err
+			}
+			if txFlags.Unsigned() {
+				return nil
+			}
+			fmt.Println("Transaction sent:", tx.Hash().Hex())
+
+			if timeout == 0 {
+				return nil
+			}
+
+			receipt, err := transaction.WaitMined(context.Background(), l1Client, tx)
+			if err != nil {
+				return err
+			}
+
+			messages, err := GetSentMessagesFromReceipt(receipt, messenger)
+			if err != nil {
+				return err
+			}
+			message := messages[len(messages)-1]
+			fmt.Println("Message hash:", message.Hash.Hex())
+			fmt.Println("Waiting for the message to be relayed on L2")
+
+			log, relayed, err := WaitForRelayedMessage(context.Background(), l2Client, message.Hash, l2Head, pollInterval, timeout)
+			if err != nil {
+				return err
+			}
+			if !relayed {
+				return fmt.Errorf("message %s failed to relay on L2 in transaction %s, it can be replayed on L2CrossDomainMessenger", message.Hash.Hex(), log.TxHash.Hex())
+			}
+			fmt.Println("Message relayed on L2 in transaction", log.TxHash.Hex())
+
+			return nil
+		},
+	}
+
+	messageCmd.Flags().StringVar(&password, "password", "", "Password to decrypt the keyfile with")
+	messageCmd.Flags().StringVar(&keyFile, "keyfile", "", "Keyfile to sign transaction with")
+	messageCmd.Flags().StringVar(&l1Rpc, "l1-rpc", "", "L1 RPC URL")
+	messageCmd.Flags().StringVar(&l2Rpc, "l2-rpc", "", "L2 RPC URL")
+	messageCmd.Flags().StringVar(&messengerRaw, "messenger", "", "L1CrossDomainMessenger address")
+	messageCmd.Flags().StringVar(&toRaw, "to", "", "Recipient or contract address on L2")
+	messageCmd.Flags().StringVar(&amountRaw, "amount", "", "L2 call value, in wei")
+	messageCmd.Flags().StringVar(&l2CalldataRaw, "l2-calldata", "", "Hex calldata of the L2 call")
+	messageCmd.Flags().Uint32Var(&minGasLimit, "min-gas-limit", 0, "Minimum gas limit of the L2 call (optional, estimated on L2 by default)")
+	messageCmd.Flags().DurationVar(&pollInterval, "poll-interval", DefaultRelayPollInterval, "Interval between checks for the relay on L2")
+	messageCmd.Flags().DurationVar(&timeout, "timeout", DefaultRelayTimeout, "Maximum time to wait for the relay on L2, 0 to not wait")
+	safeFlags.AddFlags(messageCmd)
+	txFlags.AddFlags(messageCmd)
+
+	return messageCmd
+}
@@ -0,0 +1,91 @@
+package base
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/G7DAO/bifrost/bindings/L1CrossDomainMessenger"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestMessengerBaseGas(t *testing.T) {
+	testCases := []struct {
+		messageLength int
+		minGasLimit   uint32
+		expected      uint64
+	}{
+		// 21000 + 285000 + (0 + 260) * 16
+		{messageLength: 0, minGasLimit: 0, expected: 310_160},
+		// 21000 + 285000 + 200000 * 64 / 63 + (0 + 260) * 16
+		{messageLength: 0, minGasLimit: 200_000, expected: 513_334},
+		// 21000 + 285000 + 100000 * 64 / 63 + (100 + 260) * 16
+		{messageLength: 100, minGasLimit: 100_000, expected: 413_347},
+		// The calldata floor wins for large messages: 21000 + (100000 + 260) * 40
+		{messageLength: 100_000, minGasLimit: 0, expected: 4_031_400},
+	}
+
+	for _, testCase := range testCases {
+		if baseGas := MessengerBaseGas(testCase.messageLength, testCase.minGasLimit); baseGas != testCase.expected {
+			t.Errorf("MessengerBaseGas(%d, %d): expected %d, got %d", testCase.messageLength, testCase.minGasLimit, testCase.expected, baseGas)
+		}
+	}
+}
+
+func TestGetSentMessagesFromReceipt(t *testing.T) {
+	messengerAbi, err := L1CrossDomainMessenger.L1CrossDomainMessengerMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	messenger := common.HexToAddress("0xC34855F4De64F1840e5686e64278da901e261f20")
+	sender := common.HexToAddress("0x3154Cf16ccdb4C6d922629664174b904d80F2C35")
+	target := common.HexToAddress("0x2Fc99fd16D8D3F6F66d164aA84E244c567E58A3d")
+	nonce := new(big.Int).Add(new(big.Int).Lsh(big.NewInt(1), 240), big.NewInt(12))
+	value := big.NewInt(5)
+	message := []byte{0xd0, 0x9d, 0xe0, 0x8a}
+
+	sentData, err := messengerAbi.Events["SentMessage"].Inputs.NonIndexed().Pack(sender, message, nonce, big.NewInt(100_000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	extensionData, err := messengerAbi.Events["SentMessageExtension1"].Inputs.NonIndexed().Pack(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	receipt := &types.Receipt{Logs: []*types.Log{
+		{Address: messenger, Topics: []common.Hash{messengerAbi.Events["SentMessage"].ID, common.BytesToHash(target.Bytes())}, Data: sentData},
+		{Address: messenger, Topics: []common.Hash{messengerAbi.Events["SentMessageExtension1"].ID, common.BytesToHash(sender.Bytes())}, Data: extensionData, Index: 1},
+	}}
+
+	messages, err := GetSentMessagesFromReceipt(receipt, messenger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(messages) != 1 {
+		t.Fatalf("expected 1 message, got %d", len(messages))
+	}
+	sent := messages[0]
+	if sent.Target != target || sent.Sender != sender || sent.Value.Cmp(value) != 0 || sent.MinGasLimit.Int64() != 100_000 {
+		t.Errorf("unexpected message %+v", sent)
+	}
+
+	relayMessageData, err := messengerAbi.Pack("relayMessage", nonce, sender, target, value, big.NewInt(100_000), message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := crypto.Keccak256Hash(relayMessageData); sent.Hash != expected {
+		t.Errorf("expected message hash %s, got %s", expected.Hex(), sent.Hash.Hex())
+	}
+
+	if _, err := GetSentMessagesFromReceipt(receipt, sender); err == nil {
+		t.Error("expected an error without messages of the messenger")
+	}
+
+	sent.Nonce = big.NewInt(12)
+	if _, err := HashCrossDomainMessage(sent); err == nil {
+		t.Error("expected an error on a version 0 nonce")
+	}
+}
@@ -0,0 +1,204 @@
+package base
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/G7DAO/bifrost/bindings/ERC20"
+	"github.com/G7DAO/bifrost/bindings/OptimismMintableERC20"
+	"github.com/G7DAO/bifrost/bindings/OptimismMintableERC20Factory"
+	"github.com/G7DAO/bifrost/cmd/transaction"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// OptimismMintableERC20FactoryAddress is the factory predeploy on OP Stack chains
+var OptimismMintableERC20FactoryAddress = common.HexToAddress("0x4200000000000000000000000000000000000012")
+
+// DefaultTokenDecimals are the decimals of tokens deployed with createOptimismMintableERC20
+const DefaultTokenDecimals uint8 = 18
+
+// TokenDeployment is an OptimismMintableERC20 to deploy with the factory
+type TokenDeployment struct {
+	RemoteToken common.Address
+	Name        string
+	Symbol      string
+	Decimals    uint8
+}
+
+// GetRemoteTokenMetadata returns the name, symbol and decimals of the L1 token
+func GetRemoteTokenMetadata(l1Client *ethclient.Client, remoteToken common.Address) (string, string, uint8, error) {
+	token, err := ERC20.NewERC20(remoteToken, l1Client)
+	if err != nil {
+		return "", "", 0, err
+	}
+
+	name, err := token.Name(&bind.CallOpts{})
+	if err != nil {
+		return "", "", 0, fmt.Errorf("failed to fetch name of %s: %v", remoteToken.Hex(), err)
+	}
+	symbol, err := token.Symbol(&bind.CallOpts{})
+	if err != nil {
+		return "", "", 0, fmt.Errorf("failed to fetch symbol of %s: %v", remoteToken.Hex(), err)
+	}
+	decimals, err := token.Decimals(&bind.CallOpts{})
+	if err != nil {
+		return "", "", 0, fmt.Errorf("failed to fetch decimals of %s: %v", remoteToken.Hex(), err)
+	}
+
+	return name, symbol, decimals, nil
+}
+
+// GetCreateTokenCalldata uses createOptimismMintableERC20WithDecimals only when the decimals differ
+// from the default, so that tokens with 18 decimals can be deployed with factories that predate it
+func GetCreateTokenCalldata(deployment *TokenDeployment) ([]byte, error) {
+	factoryAbi, err := OptimismMintableERC20Factory.OptimismMintableERC20FactoryMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	if deployment.Decimals == DefaultTokenDecimals {
+		return factoryAbi.Pack("createOptimismMintableERC20", deployment.RemoteToken, deployment.Name, deployment.Symbol)
+	}
+	return factoryAbi.Pack("createOptimismMintableERC20WithDecimals", deployment.RemoteToken, deployment.Name, deployment.Symbol, deployment.Decimals)
+}
+
+// PredictTokenAddress returns the CREATE2 address the factory deploys the token at. The init code
+// hashed into the address is the OptimismMintableERC20 bytecode of the factory's own version, so the
+// deployment is simulated with eth_call rather than computed locally.
+func PredictTokenAddress(l2Client *ethclient.Client, factory common.Address, deployer common.Address, createData []byte) (common.Address, error) {
+	output, err := l2Client.CallContract(context.Background(), ethereum.CallMsg{From: deployer, To: &factory, Data: createData}, nil)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to simulate the deployment, is the token already deployed? %v", err)
+	}
+	if len(output) != 32 {
+		return common.Address{}, fmt.Errorf("unexpected output of the deployment simulation: %x", output)
+	}
+
+	return common.BytesToAddress(output), nil
+}
+
+// GetCreatedToken returns the OptimismMintableERC20Created event of the factory in the receipt
+func GetCreatedToken(receipt *types.Receipt, factory common.Address) (*OptimismMintableERC20Factory.OptimismMintableERC20FactoryOptimismMintableERC20Created, error) {
+	factoryFilterer, err := OptimismMintableERC20Factory.NewOptimismMintableERC20FactoryFilterer(factory, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	factoryAbi, err := OptimismMintableERC20Factory.OptimismMintableERC20FactoryMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	createdID := factoryAbi.Events["OptimismMintableERC20Created"].ID
+
+	for _, log := range receipt.Logs {
+		if log.Address != factory || len(log.Topics) == 0 || log.Topics[0] != createdID {
+			continue
+		}
+		return factoryFilterer.ParseOptimismMintableERC20Created(*log)
+	}
+
+	return nil, errors.New("no OptimismMintableERC20Created event found in the transaction")
+}
+
+// GetTokenBridge returns the L2 bridge allowed to mint and burn an OptimismMintableERC20, falling
+// back to the legacy l2Bridge getter
+func GetTokenBridge(l2Client *ethclient.Client, l2Token common.Address) (common.Address, error) {
+	token, err := OptimismMintableERC20.NewOptimismMintableERC20(l2Token, l2Client)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	bridge, bridgeErr := token.Bridge(&bind.CallOpts{})
+	if bridgeErr == nil {
+		return bridge, nil
+	}
+
+	bridge, l2BridgeErr := token.L2Bridge(&bind.CallOpts{})
+	if l2BridgeErr != nil {
+		return common.Address{}, fmt.Errorf("failed to fetch the bridge of %s: %v", l2Token.Hex(), bridgeErr)
+	}
+	return bridge, nil
+}
+
+// VerifyTokenPair checks that the L2 token bridges the remote token through the bridge
+func VerifyTokenPair(l2Client *ethclient.Client, l2Token common.Address, remoteToken common.Address, bridge common.Address) error {
+	tokenRemoteToken, err := GetRemoteToken(l2Client, l2Token)
+	if err != nil {
+		return err
+	}
+	if tokenRemoteToken != remoteToken {
+		return fmt.Errorf("remote token of %s is %s, expected %s", l2Token.Hex(), tokenRemoteToken.Hex(), remoteToken.Hex())
+	}
+
+	tokenBridge, err := GetTokenBridge(l2Client, l2Token)
+	if err != nil {
+		return err
+	}
+	if tokenBridge != bridge {
+		return fmt.Errorf("bridge of %s is %s, expected %s", l2Token.Hex(), tokenBridge.Hex(), bridge.Hex())
+	}
+
+	return nil
+}
+
+// DeployToken deploys the token with the factory, waits for the deployment and verifies the pair of
+// the deployed token
+func DeployToken(key *keystore.Key, l2Client *ethclient.Client, factoryAddress common.Address, deployment *TokenDeployment, txFlags *transaction.Flags) (common.Address, error) {
+	factory, err := OptimismMintableERC20Factory.NewOptimismMintableERC20Factory(factoryAddress, l2Client)
+	if err != nil {
+		return common.Address{}, err
+	}
+	bridge, err := factory.BRIDGE(&bind.CallOpts{})
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to fetch the bridge of factory %s: %v", factoryAddress.Hex(), err)
+	}
+
+	createData, err := GetCreateTokenCalldata(deployment)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	predicted, err := PredictTokenAddress(l2Client, factoryAddress, key.Address, createData)
+	if err != nil {
+		return common.Address{}, err
+	}
+	fmt.Println("Predicted token address:", predicted.Hex())
+
+	tx, err := transaction.Send(context.Background(), l2Client, key, factoryAddress, big.NewInt(0), createData, txFlags)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if txFlags.Unsigned() {
+		return predicted, nil
+	}
+	fmt.Println("Transaction sent:", tx.Hash().Hex())
+
+	receipt, err := transaction.WaitMined(context.Background(), l2Client, tx)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	created, err := GetCreatedToken(receipt, factoryAddress)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if created.LocalToken != predicted {
+		return common.Address{}, fmt.Errorf("token deployed at %s, predicted %s", created.LocalToken.Hex(), predicted.Hex())
+	}
+	if created.RemoteToken != deployment.RemoteToken {
+		return common.Address{}, fmt.Errorf("token deployed for remote token %s, expected %s", created.RemoteToken.Hex(), deployment.RemoteToken.Hex())
+	}
+
+	if err := VerifyTokenPair(l2Client, created.LocalToken, deployment.RemoteToken, bridge); err != nil {
+		return common.Address{}, fmt.Errorf("token deployed at %s failed verification: %v", created.LocalToken.Hex(), err)
+	}
+
+	return created.LocalToken, nil
+}
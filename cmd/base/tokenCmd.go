@@ -0,0 +1,136 @@
+package base
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/G7DAO/bifrost/cmd/transaction"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/cobra"
+)
+
+func CreateTokenCommand() *cobra.Command {
+	tokenCmd := &cobra.Command{
+		Use:   "token",
+		Short: "Manage OptimismMintableERC20 tokens on an OP Stack chain",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	tokenCmd.AddCommand(CreateTokenDeployCommand())
+
+	return tokenCmd
+}
+
+func CreateTokenDeployCommand() *cobra.Command {
+	var keyFile, password, l1Rpc, l2Rpc, factoryRaw, remoteTokenRaw, name, symbol string
+	var decimals uint8
+	var factory common.Address
+	deployment := &TokenDeployment{}
+	txFlags := &transaction.Flags{}
+
+	deployCmd := &cobra.Command{
+		Use:   "deploy",
+		Short: "Deploy an OptimismMintableERC20 for an L1 token",
+		Long: `Deploy an OptimismMintableERC20 for an L1 token with OptimismMintableERC20Factory
+
+The address of the token is predicted before the deployment, and the remoteToken and bridge of the
+deployed token are checked against --remote-token and the bridge of the factory. With --l1-rpc, the
+name, symbol and decimals default to those of the L1 token.`,
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if l2Rpc == "" {
+				return errors.New("l2-rpc is required")
+			}
+
+			if !common.IsHexAddress(factoryRaw) {
+				return errors.New("invalid OptimismMintableERC20Factory address")
+			}
+			factory = common.HexToAddress(factoryRaw)
+
+			if !common.IsHexAddress(remoteTokenRaw) {
+				return errors.New("invalid remote token address")
+			}
+			deployment.RemoteToken = common.HexToAddress(remoteTokenRaw)
+
+			deployment.Name, deployment.Symbol, deployment.Decimals = name, symbol, DefaultTokenDecimals
+			if l1Rpc != "" {
+				l1Client, l1ClientErr := ethclient.Dial(l1Rpc)
+				if l1ClientErr != nil {
+					return l1ClientErr
+				}
+
+				remoteName, remoteSymbol, remoteDecimals, metadataErr := GetRemoteTokenMetadata(l1Client, deployment.RemoteToken)
+				if metadataErr != nil {
+					return metadataErr
+				}
+				if name == "" {
+					deployment.Name = remoteName
+				}
+				if symbol == "" {
+					deployment.Symbol = remoteSymbol
+				}
+				deployment.Decimals = remoteDecimals
+			}
+			if cmd.Flags().Changed("decimals") {
+				if l1Rpc != "" && decimals != deployment.Decimals {
+					fmt.Println("Warning: --decimals", decimals, "differs from the", deployment.Decimals, "decimals of the remote token")
+				}
+				deployment.Decimals = decimals
+			}
+
+			if deployment.Name == "" || deployment.Symbol == "" {
+				return errors.New("name and symbol are required without --l1-rpc")
+			}
+
+			if keyFile == "" && !txFlags.Unsigned() {
+				return errors.New("keyfile is required")
+			}
+
+			if txErr := txFlags.Parse(); txErr != nil {
+				return txErr
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fmt.Println("Deploying", deployment.Name, "(", deployment.Symbol, ") with", deployment.Decimals, "decimals for", deployment.RemoteToken.Hex())
+
+			key, err := txFlags.Key(keyFile, password)
+			if err != nil {
+				return err
+			}
+
+			l2Client, err := ethclient.Dial(l2Rpc)
+			if err != nil {
+				return err
+			}
+
+			token, err := DeployToken(key, l2Client, factory, deployment, txFlags)
+			if err != nil {
+				return err
+			}
+			if txFlags.Unsigned() {
+				return nil
+			}
+			fmt.Println("Token deployed and verified at:", token.Hex())
+
+			return nil
+		},
+	}
+
+	deployCmd.Flags().StringVar(&password, "password", "", "Password to decrypt the keyfile with")
+	deployCmd.Flags().StringVar(&keyFile, "keyfile", "", "Keyfile to sign transaction with")
+	deployCmd.Flags().StringVar(&l2Rpc, "l2-rpc", "", "L2 RPC URL")
+	deployCmd.Flags().StringVar(&l1Rpc, "l1-rpc", "", "L1 RPC URL, to read the metadata of the remote token (optional)")
+	deployCmd.Flags().StringVar(&factoryRaw, "factory", OptimismMintableERC20FactoryAddress.Hex(), "OptimismMintableERC20Factory address")
+	deployCmd.Flags().StringVar(&remoteTokenRaw, "remote-token", "", "L1 token address")
+	deployCmd.Flags().StringVar(&name, "name", "", "Token name (optional with --l1-rpc)")
+	deployCmd.Flags().StringVar(&symbol, "symbol", "", "Token symbol (optional with --l1-rpc)")
+	deployCmd.Flags().Uint8Var(&decimals, "decimals", DefaultTokenDecimals, "Token decimals (optional, defaults to the decimals of the remote token with --l1-rpc)")
+	txFlags.AddFlags(deployCmd)
+
+	return deployCmd
+}
@@ -0,0 +1,74 @@
+package base
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/G7DAO/bifrost/bindings/OptimismMintableERC20Factory"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestGetCreateTokenCalldata(t *testing.T) {
+	factoryAbi, err := OptimismMintableERC20Factory.OptimismMintableERC20FactoryMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deployment := &TokenDeployment{
+		RemoteToken: common.HexToAddress("0x1c7D4B196Cb0C7B01d743Fbc6116a902379C7238"),
+		Name:        "USD Coin",
+		Symbol:      "USDC",
+		Decimals:    DefaultTokenDecimals,
+	}
+
+	createData, err := GetCreateTokenCalldata(deployment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(createData[:4], factoryAbi.Methods["createOptimismMintableERC20"].ID) {
+		t.Errorf("expected createOptimismMintableERC20 with %d decimals", DefaultTokenDecimals)
+	}
+
+	deployment.Decimals = 6
+	createData, err = GetCreateTokenCalldata(deployment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(createData[:4], factoryAbi.Methods["createOptimismMintableERC20WithDecimals"].ID) {
+		t.Error("expected createOptimismMintableERC20WithDecimals with 6 decimals")
+	}
+}
+
+func TestGetCreatedToken(t *testing.T) {
+	factoryAbi, err := OptimismMintableERC20Factory.OptimismMintableERC20FactoryMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	localToken := common.HexToAddress("0x036CbD53842c5426634e7929541eC2318f3dCF7e")
+	remoteToken := common.HexToAddress("0x1c7D4B196Cb0C7B01d743Fbc6116a902379C7238")
+	deployer := common.HexToAddress("0x3154Cf16ccdb4C6d922629664174b904d80F2C35")
+
+	createdData, err := factoryAbi.Events["OptimismMintableERC20Created"].Inputs.NonIndexed().Pack(deployer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	receipt := &types.Receipt{Logs: []*types.Log{{
+		Address: OptimismMintableERC20FactoryAddress,
+		Topics:  []common.Hash{factoryAbi.Events["OptimismMintableERC20Created"].ID, common.BytesToHash(localToken.Bytes()), common.BytesToHash(remoteToken.Bytes())},
+		Data:    createdData,
+	}}}
+
+	created, err := GetCreatedToken(receipt, OptimismMintableERC20FactoryAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.LocalToken != localToken || created.RemoteToken != remoteToken || created.Deployer != deployer {
+		t.Errorf("unexpected event %+v", created)
+	}
+
+	if _, err := GetCreatedToken(receipt, deployer); err == nil {
+		t.Error("expected an error without event of the factory")
+	}
+}
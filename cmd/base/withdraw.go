@@ -0,0 +1,486 @@
+package base
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/G7DAO/bifrost/bindings/DisputeGameFactory"
+	"github.com/G7DAO/bifrost/bindings/FaultDisputeGame"
+	"github.com/G7DAO/bifrost/bindings/L2StandardBridge"
+	"github.com/G7DAO/bifrost/bindings/L2ToL1MessagePasser"
+	"github.com/G7DAO/bifrost/bindings/OptimismPortal"
+	"github.com/G7DAO/bifrost/cmd/transaction"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// OP Stack predeploys, at the same address on every OP Stack chain
+// Source: https://github.com/ethereum-optimism/optimism/blob/develop/packages/contracts-bedrock/src/libraries/Predeploys.sol
+var (
+	L2StandardBridgeAddress    = common.HexToAddress("0x4200000000000000000000000000000000000010")
+	L2ToL1MessagePasserAddress = common.HexToAddress("0x4200000000000000000000000000000000000016")
+	// LegacyERC20ETH is the token address L2StandardBridge.withdrawTo takes to withdraw ETH
+	LegacyERC20ETH = common.HexToAddress("0xDeadDeAddeAddEAddeadDEaDDEAdDeaDDeAD0000")
+)
+
+const DefaultWithdrawalMinGasLimit uint32 = 200_000
+
+// Status of a dispute game
+// Source: https://github.com/ethereum-optimism/optimism/blob/develop/packages/contracts-bedrock/src/dispute/lib/Types.sol
+const (
+	GameStatusInProgress     uint8 = 0
+	GameStatusChallengerWins uint8 = 1
+	GameStatusDefenderWins   uint8 = 2
+)
+
+const (
+	WithdrawalStatusWaitingForGame    = "waiting for a dispute game covering the withdrawal"
+	WithdrawalStatusReadyToProve      = "ready to prove"
+	WithdrawalStatusInChallengePeriod = "proven, in challenge period"
+	WithdrawalStatusReadyToFinalize   = "ready to finalize"
+	WithdrawalStatusFinalized         = "finalized"
+)
+
+// disputeGamePageSize is the number of games fetched per findLatestGames call
+const disputeGamePageSize = 50
+
+// Withdrawal is a message passed from L2 to L1 by L2ToL1MessagePasser
+type Withdrawal struct {
+	Transaction OptimismPortal.TypesWithdrawalTransaction
+	Hash        common.Hash
+	BlockNumber uint64
+}
+
+// DisputeGame is a game of the DisputeGameFactory proposing an output root of L2
+type DisputeGame struct {
+	Index         *big.Int
+	Proxy         common.Address
+	L2BlockNumber uint64
+	RootClaim     common.Hash
+}
+
+func GetInitiateWithdrawalCalldata(target common.Address, gasLimit *big.Int, data []byte) ([]byte, error) {
+	messagePasserAbi, err := L2ToL1MessagePasser.L2ToL1MessagePasserMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	return messagePasserAbi.Pack("initiateWithdrawal", target, gasLimit, data)
+}
+
+func GetWithdrawToCalldata(l2Token common.Address, to common.Address, amount *big.Int, minGasLimit uint32, extraData []byte) ([]byte, error) {
+	bridgeAbi, err := L2StandardBridge.L2StandardBridgeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	return bridgeAbi.Pack("withdrawTo", l2Token, to, amount, minGasLimit, extraData)
+}
+
+// HashWithdrawal returns the hash under which the portal tracks the withdrawal
+// Source: https://github.com/ethereum-optimism/optimism/blob/develop/packages/contracts-bedrock/src/libraries/Hashing.sol
+func HashWithdrawal(transaction OptimismPortal.TypesWithdrawalTransaction) (common.Hash, error) {
+	uint256Type, _ := abi.NewType("uint256", "", nil)
+	addressType, _ := abi.NewType("address", "", nil)
+	bytesType, _ := abi.NewType("bytes", "", nil)
+	arguments := abi.Arguments{{Type: uint256Type}, {Type: addressType}, {Type: addressType}, {Type: uint256Type}, {Type: uint256Type}, {Type: bytesType}}
+
+	encoded, err := arguments.Pack(transaction.Nonce, transaction.Sender, transaction.Target, transaction.Value, transaction.GasLimit, transaction.Data)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+// WithdrawalStorageSlot returns the slot of L2ToL1MessagePasser.sentMessages[withdrawalHash], the
+// mapping being the first storage variable of the contract
+func WithdrawalStorageSlot(withdrawalHash common.Hash) common.Hash {
+	return crypto.Keccak256Hash(withdrawalHash.Bytes(), common.Hash{}.Bytes())
+}
+
+// ComputeOutputRoot returns the output root committed to by a dispute game
+// Source: https://github.com/ethereum-optimism/optimism/blob/develop/packages/contracts-bedrock/src/libraries/Hashing.sol
+func ComputeOutputRoot(proof OptimismPortal.TypesOutputRootProof) common.Hash {
+	return crypto.Keccak256Hash(proof.Version[:], proof.StateRoot[:], proof.MessagePasserStorageRoot[:], proof.LatestBlockhash[:])
+}
+
+// GetWithdrawalsFromReceipt returns the withdrawals of the L2 transaction, in log order
+func GetWithdrawalsFromReceipt(receipt *types.Receipt) ([]*Withdrawal, error) {
+	messagePasser, err := L2ToL1MessagePasser.NewL2ToL1MessagePasserFilterer(L2ToL1MessagePasserAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	messagePasserAbi, err := L2ToL1MessagePasser.L2ToL1MessagePasserMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	messagePassedID := messagePasserAbi.Events["MessagePassed"].ID
+
+	var withdrawals []*Withdrawal
+	for _, log := range receipt.Logs {
+		if log.Address != L2ToL1MessagePasserAddress || len(log.Topics) == 0 || log.Topics[0] != messagePassedID {
+			continue
+		}
+
+		passed, parseErr := messagePasser.ParseMessagePassed(*log)
+		if parseErr != nil {
+			return nil, fmt.Errorf("failed to parse MessagePassed log %d: %v", log.Index, parseErr)
+		}
+
+		withdrawal := &Withdrawal{
+			Transaction: OptimismPortal.TypesWithdrawalTransaction{
+				Nonce:    passed.Nonce,
+				Sender:   passed.Sender,
+				Target:   passed.Target,
+				Value:    passed.Value,
+				GasLimit: passed.GasLimit,
+				Data:     passed.Data,
+			},
+			Hash:        passed.WithdrawalHash,
+			BlockNumber: receipt.BlockNumber.Uint64(),
+		}
+
+		hash, hashErr := HashWithdrawal(withdrawal.Transaction)
+		if hashErr != nil {
+			return nil, hashErr
+		}
+		if hash != withdrawal.Hash {
+			return nil, fmt.Errorf("withdrawal hash mismatch in log %d: computed %s, emitted %s", log.Index, hash.Hex(), withdrawal.Hash.Hex())
+		}
+
+		withdrawals = append(withdrawals, withdrawal)
+	}
+
+	if len(withdrawals) == 0 {
+		return nil, errors.New("no MessagePassed event found in the transaction")
+	}
+	return withdrawals, nil
+}
+
+// GetWithdrawals returns the withdrawals initiated by the L2 transaction
+func GetWithdrawals(l2Client *ethclient.Client, txHash common.Hash) ([]*Withdrawal, error) {
+	receipt, err := l2Client.TransactionReceipt(context.Background(), txHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch receipt of %s: %v", txHash.Hex(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("transaction %s reverted", txHash.Hex())
+	}
+
+	return GetWithdrawalsFromReceipt(receipt)
+}
+
+// GetWithdrawal returns the withdrawal at withdrawalIndex among those of the L2 transaction
+func GetWithdrawal(l2Client *ethclient.Client, txHash common.Hash, withdrawalIndex int) (*Withdrawal, error) {
+	withdrawals, err := GetWithdrawals(l2Client, txHash)
+	if err != nil {
+		return nil, err
+	}
+	if withdrawalIndex < 0 || withdrawalIndex >= len(withdrawals) {
+		return nil, fmt.Errorf("invalid withdrawal index %d, the transaction initiated %d withdrawal(s)", withdrawalIndex, len(withdrawals))
+	}
+
+	return withdrawals[withdrawalIndex], nil
+}
+
+// FindDisputeGame returns the latest game of the respected game type that proposes an output at or
+// after the L2 block, skipping games the challenger won, or nil if there is none yet
+func FindDisputeGame(l1Client *ethclient.Client, portalAddress common.Address, l2BlockNumber uint64) (*DisputeGame, error) {
+	portal, err := OptimismPortal.NewOptimismPortal(portalAddress, l1Client)
+	if err != nil {
+		return nil, err
+	}
+
+	factoryAddress, err := portal.DisputeGameFactory(&bind.CallOpts{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch DisputeGameFactory of OptimismPortal %s: %v", portalAddress.Hex(), err)
+	}
+	gameType, err := portal.RespectedGameType(&bind.CallOpts{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch respected game type: %v", err)
+	}
+
+	factory, err := DisputeGameFactory.NewDisputeGameFactory(factoryAddress, l1Client)
+	if err != nil {
+		return nil, err
+	}
+	gameCount, err := factory.GameCount(&bind.CallOpts{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch game count: %v", err)
+	}
+
+	start := new(big.Int).Sub(gameCount, big.NewInt(1))
+	for start.Sign() >= 0 {
+		// Games are returned from the most recent, which propose the most recent L2 blocks
+		games, findErr := factory.FindLatestGames(&bind.CallOpts{}, gameType, start, big.NewInt(disputeGamePageSize))
+		if findErr != nil {
+			return nil, fmt.Errorf("failed to search dispute games: %v", findErr)
+		}
+		if len(games) == 0 {
+			return nil, nil
+		}
+
+		for _, result := range games {
+			game, parseErr := parseGameSearchResult(result)
+			if parseErr != nil {
+				return nil, parseErr
+			}
+			if game.L2BlockNumber < l2BlockNumber {
+				return nil, nil
+			}
+
+			faultDisputeGame, gameErr := FaultDisputeGame.NewFaultDisputeGame(game.Proxy, l1Client)
+			if gameErr != nil {
+				return nil, gameErr
+			}
+			status, statusErr := faultDisputeGame.Status(&bind.CallOpts{})
+			if statusErr != nil {
+				return nil, fmt.Errorf("failed to fetch status of dispute game %s: %v", game.Proxy.Hex(), statusErr)
+			}
+			if status == GameStatusChallengerWins {
+				continue
+			}
+
+			blacklisted, blacklistErr := portal.DisputeGameBlacklist(&bind.CallOpts{}, game.Proxy)
+			if blacklistErr != nil {
+				return nil, fmt.Errorf("failed to check if dispute game %s is blacklisted: %v", game.Proxy.Hex(), blacklistErr)
+			}
+			if blacklisted {
+				continue
+			}
+
+			return game, nil
+		}
+
+		start = new(big.Int).Sub(games[len(games)-1].Index, big.NewInt(1))
+	}
+
+	return nil, nil
+}
+
+// parseGameSearchResult decodes the proxy from the game ID, which packs the game type, creation
+// timestamp and proxy address, and the L2 block number from the first word of the extra data
+func parseGameSearchResult(result DisputeGameFactory.IDisputeGameFactoryGameSearchResult) (*DisputeGame, error) {
+	if len(result.ExtraData) < 32 {
+		return nil, fmt.Errorf("invalid extra data of dispute game %s", result.Index.String())
+	}
+
+	return &DisputeGame{
+		Index:         result.Index,
+		Proxy:         common.BytesToAddress(result.Metadata[12:]),
+		L2BlockNumber: new(big.Int).SetBytes(result.ExtraData[:32]).Uint64(),
+		RootClaim:     result.RootClaim,
+	}, nil
+}
+
+// accountProof is the response of eth_getProof
+type accountProof struct {
+	StorageHash  common.Hash `json:"storageHash"`
+	StorageProof []struct {
+		Key   string          `json:"key"`
+		Value *hexutil.Big    `json:"value"`
+		Proof []hexutil.Bytes `json:"proof"`
+	} `json:"storageProof"`
+}
+
+// BuildWithdrawalProof builds the output root proof of the L2 block proposed by the game and the
+// storage proof of the withdrawal in L2ToL1MessagePasser at that block
+func BuildWithdrawalProof(l2Client *ethclient.Client, withdrawal *Withdrawal, game *DisputeGame) (OptimismPortal.TypesOutputRootProof, [][]byte, error) {
+	var outputRootProof OptimismPortal.TypesOutputRootProof
+	blockNumber := new(big.Int).SetUint64(game.L2BlockNumber)
+
+	header, err := l2Client.HeaderByNumber(context.Background(), blockNumber)
+	if err != nil {
+		return outputRootProof, nil, fmt.Errorf("failed to fetch L2 block %d: %v", game.L2BlockNumber, err)
+	}
+
+	slot := WithdrawalStorageSlot(withdrawal.Hash)
+	var proof accountProof
+	err = l2Client.Client().CallContext(context.Background(), &proof, "eth_getProof", L2ToL1MessagePasserAddress, []string{slot.Hex()}, hexutil.EncodeBig(blockNumber))
+	if err != nil {
+		return outputRootProof, nil, fmt.Errorf("failed to fetch storage proof of the withdrawal: %v", err)
+	}
+	if len(proof.StorageProof) != 1 || proof.StorageProof[0].Value == nil || proof.StorageProof[0].Value.ToInt().Sign() == 0 {
+		return outputRootProof, nil, fmt.Errorf("withdrawal %s is not in L2ToL1MessagePasser at block %d", withdrawal.Hash.Hex(), game.L2BlockNumber)
+	}
+
+	outputRootProof = OptimismPortal.TypesOutputRootProof{
+		StateRoot:                header.Root,
+		MessagePasserStorageRoot: proof.StorageHash,
+		LatestBlockhash:          header.Hash(),
+	}
+	if outputRoot := ComputeOutputRoot(outputRootProof); outputRoot != game.RootClaim {
+		return outputRootProof, nil, fmt.Errorf("output root %s of L2 block %d does not match the root claim %s of dispute game %s", outputRoot.Hex(), game.L2BlockNumber, game.RootClaim.Hex(), game.Proxy.Hex())
+	}
+
+	withdrawalProof := make([][]byte, len(proof.StorageProof[0].Proof))
+	for i, node := range proof.StorageProof[0].Proof {
+		withdrawalProof[i] = node
+	}
+
+	return outputRootProof, withdrawalProof, nil
+}
+
+// WithdrawalState is where a withdrawal stands on L1
+type WithdrawalState struct {
+	Status string
+	// Game is the dispute game the withdrawal can be proven against, before it is proven
+	Game *DisputeGame
+	// ProofSubmitter is the account whose proof can finalize the withdrawal, or the first one to
+	// prove it if none can yet
+	ProofSubmitter common.Address
+	ProvenAt       time.Time
+	// MaturesAt is when the proof maturity delay of ProofSubmitter's proof expires
+	MaturesAt time.Time
+}
+
+// GetWithdrawalState returns where the withdrawal stands on L1
+func GetWithdrawalState(l1Client *ethclient.Client, portalAddress common.Address, withdrawal *Withdrawal) (*WithdrawalState, error) {
+	portal, err := OptimismPortal.NewOptimismPortal(portalAddress, l1Client)
+	if err != nil {
+		return nil, err
+	}
+
+	finalized, err := portal.FinalizedWithdrawals(&bind.CallOpts{}, withdrawal.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check if withdrawal %s is finalized: %v", withdrawal.Hash.Hex(), err)
+	}
+	if finalized {
+		return &WithdrawalState{Status: WithdrawalStatusFinalized}, nil
+	}
+
+	submitterCount, err := portal.NumProofSubmitters(&bind.CallOpts{}, withdrawal.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch proof submitters of withdrawal %s: %v", withdrawal.Hash.Hex(), err)
+	}
+
+	if submitterCount.Sign() == 0 {
+		game, gameErr := FindDisputeGame(l1Client, portalAddress, withdrawal.BlockNumber)
+		if gameErr != nil {
+			return nil, gameErr
+		}
+		if game == nil {
+			return &WithdrawalState{Status: WithdrawalStatusWaitingForGame}, nil
+		}
+		return &WithdrawalState{Status: WithdrawalStatusReadyToProve, Game: game}, nil
+	}
+
+	maturityDelay, err := portal.ProofMaturityDelaySeconds(&bind.CallOpts{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch proof maturity delay: %v", err)
+	}
+
+	var state *WithdrawalState
+	for i := int64(0); i < submitterCount.Int64(); i++ {
+		submitter, submitterErr := portal.ProofSubmitters(&bind.CallOpts{}, withdrawal.Hash, big.NewInt(i))
+		if submitterErr != nil {
+			return nil, fmt.Errorf("failed to fetch proof submitter %d of withdrawal %s: %v", i, withdrawal.Hash.Hex(), submitterErr)
+		}
+
+		proven, provenErr := portal.ProvenWithdrawals(&bind.CallOpts{}, withdrawal.Hash, submitter)
+		if provenErr != nil {
+			return nil, fmt.Errorf("failed to fetch proof of withdrawal %s: %v", withdrawal.Hash.Hex(), provenErr)
+		}
+		provenAt := time.Unix(int64(proven.Timestamp), 0)
+		submitterState := &WithdrawalState{
+			Status:         WithdrawalStatusInChallengePeriod,
+			ProofSubmitter: submitter,
+			ProvenAt:       provenAt,
+			MaturesAt:      provenAt.Add(time.Duration(maturityDelay.Int64()) * time.Second),
+		}
+
+		// checkWithdrawal reverts unless the proof is mature and its dispute game resolved in favor of
+		// the proposal and is past its finality delay
+		if checkErr := portal.CheckWithdrawal(&bind.CallOpts{}, withdrawal.Hash, submitter); checkErr == nil {
+			submitterState.Status = WithdrawalStatusReadyToFinalize
+			return submitterState, nil
+		}
+
+		if state == nil {
+			state = submitterState
+		}
+	}
+
+	return state, nil
+}
+
+// ProveWithdrawal proves the withdrawal against the latest dispute game covering it
+func ProveWithdrawal(key *keystore.Key, l1Client *ethclient.Client, l2Client *ethclient.Client, portalAddress common.Address, withdrawal *Withdrawal, txFlags *transaction.Flags) (*types.Transaction, error) {
+	state, err := GetWithdrawalState(l1Client, portalAddress, withdrawal)
+	if err != nil {
+		return nil, err
+	}
+	if state.Status != WithdrawalStatusReadyToProve {
+		return nil, fmt.Errorf("withdrawal %s cannot be proven: %s", withdrawal.Hash.Hex(), state.Status)
+	}
+	fmt.Println("Proving against dispute game", state.Game.Index.String(), "(", state.Game.Proxy.Hex(), ") at L2 block", state.Game.L2BlockNumber)
+
+	outputRootProof, withdrawalProof, err := BuildWithdrawalProof(l2Client, withdrawal, state.Game)
+	if err != nil {
+		return nil, err
+	}
+
+	portalAbi, err := OptimismPortal.OptimismPortalMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	proveData, err := portalAbi.Pack("proveWithdrawalTransaction", withdrawal.Transaction, state.Game.Index, outputRootProof, withdrawalProof)
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction.Send(context.Background(), l1Client, key, portalAddress, big.NewInt(0), proveData, txFlags)
+}
+
+// FinalizeWithdrawal finalizes the withdrawal with the proof of proofSubmitter, or with the first
+// proof that can finalize it if proofSubmitter is not set
+func FinalizeWithdrawal(key *keystore.Key, l1Client *ethclient.Client, portalAddress common.Address, withdrawal *Withdrawal, proofSubmitter common.Address, txFlags *transaction.Flags) (*types.Transaction, error) {
+	portal, err := OptimismPortal.NewOptimismPortal(portalAddress, l1Client)
+	if err != nil {
+		return nil, err
+	}
+
+	if proofSubmitter == (common.Address{}) {
+		state, stateErr := GetWithdrawalState(l1Client, portalAddress, withdrawal)
+		if stateErr != nil {
+			return nil, stateErr
+		}
+		if state.Status != WithdrawalStatusReadyToFinalize {
+			if state.Status == WithdrawalStatusInChallengePeriod {
+				return nil, fmt.Errorf("withdrawal %s cannot be finalized yet: %s, the proof matures at %s", withdrawal.Hash.Hex(), state.Status, state.MaturesAt.UTC().Format(time.RFC3339))
+			}
+			return nil, fmt.Errorf("withdrawal %s cannot be finalized: %s", withdrawal.Hash.Hex(), state.Status)
+		}
+		proofSubmitter = state.ProofSubmitter
+	} else if checkErr := portal.CheckWithdrawal(&bind.CallOpts{}, withdrawal.Hash, proofSubmitter); checkErr != nil {
+		return nil, fmt.Errorf("withdrawal %s cannot be finalized with the proof of %s: %v", withdrawal.Hash.Hex(), proofSubmitter.Hex(), checkErr)
+	}
+
+	portalAbi, err := OptimismPortal.OptimismPortalMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	var finalizeData []byte
+	if proofSubmitter == key.Address {
+		finalizeData, err = portalAbi.Pack("finalizeWithdrawalTransaction", withdrawal.Transaction)
+	} else {
+		fmt.Println("Finalizing with the proof of", proofSubmitter.Hex())
+		finalizeData, err = portalAbi.Pack("finalizeWithdrawalTransactionExternalProof", withdrawal.Transaction, proofSubmitter)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction.Send(context.Background(), l1Client, key, portalAddress, big.NewInt(0), finalizeData, txFlags)
+}
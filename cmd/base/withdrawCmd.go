@@ -0,0 +1,448 @@
+package base
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/G7DAO/bifrost/cmd/safe"
+	"github.com/G7DAO/bifrost/cmd/transaction"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/cobra"
+)
+
+func CreateWithdrawCommand() *cobra.Command {
+	withdrawCmd := &cobra.Command{
+		Use:   "withdraw",
+		Short: "Withdraw from an OP Stack chain to L1",
+		Long: `Withdraw from an OP Stack chain to L1
+
+A withdrawal is initiated on L2, proven on L1 against a dispute game once one covers its L2 block,
+and finalized on L1 once the proof is past its maturity delay and the game is resolved.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	withdrawCmd.AddCommand(CreateWithdrawInitiateCommand())
+	withdrawCmd.AddCommand(CreateWithdrawProveCommand())
+	withdrawCmd.AddCommand(CreateWithdrawFinalizeCommand())
+	withdrawCmd.AddCommand(CreateWithdrawStatusCommand())
+
+	return withdrawCmd
+}
+
+func CreateWithdrawInitiateCommand() *cobra.Command {
+	var keyFile, password, l2Rpc, bridgeRaw, tokenRaw, toRaw, amountRaw, extraDataRaw, targetRaw, dataRaw string
+	var minGasLimit uint32
+	var bridgeAddress, token, to, target common.Address
+	var amount *big.Int
+	var extraData, data []byte
+	safeFlags := &safe.Flags{}
+	txFlags := &transaction.Flags{}
+
+	initiateCmd := &cobra.Command{
+		Use:   "initiate",
+		Short: "Initiate a withdrawal on L2",
+		Long: `Initiate a withdrawal on L2
+
+By default, ETH or --token is withdrawn to --to with L2StandardBridge.withdrawTo. With --target, a
+message is passed to --target on L1 with L2ToL1MessagePasser.initiateWithdrawal instead, carrying
+--amount wei and --data.`,
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if l2Rpc == "" {
+				return errors.New("l2-rpc is required")
+			}
+
+			if amountRaw == "" {
+				return errors.New("amount is required")
+			}
+			var ok bool
+			amount, ok = new(big.Int).SetString(amountRaw, 10)
+			if !ok || amount.Sign() < 0 {
+				return errors.New("invalid amount")
+			}
+
+			if targetRaw != "" {
+				if !common.IsHexAddress(targetRaw) {
+					return errors.New("invalid target address")
+				}
+				target = common.HexToAddress(targetRaw)
+
+				if toRaw != "" || tokenRaw != "" || extraDataRaw != "" {
+					return errors.New("--to, --token and --extra-data cannot be used with --target")
+				}
+
+				var dataErr error
+				data, dataErr = parseExtraData(dataRaw)
+				if dataErr != nil {
+					return dataErr
+				}
+			} else {
+				if dataRaw != "" {
+					return errors.New("--data requires --target")
+				}
+
+				if amount.Sign() == 0 {
+					return errors.New("invalid amount")
+				}
+
+				if !common.IsHexAddress(bridgeRaw) {
+					return errors.New("invalid L2StandardBridge address")
+				}
+				bridgeAddress = common.HexToAddress(bridgeRaw)
+
+				if !common.IsHexAddress(toRaw) {
+					return errors.New("invalid recipient address")
+				}
+				to = common.HexToAddress(toRaw)
+
+				token = LegacyERC20ETH
+				if tokenRaw != "" {
+					if !common.IsHexAddress(tokenRaw) {
+						return errors.New("invalid token address")
+					}
+					token = common.HexToAddress(tokenRaw)
+				}
+
+				var extraDataErr error
+				extraData, extraDataErr = parseExtraData(extraDataRaw)
+				if extraDataErr != nil {
+					return extraDataErr
+				}
+			}
+
+			if keyFile == "" && !txFlags.Unsigned() {
+				return errors.New("keyfile is required")
+			}
+
+			if safeErr := safeFlags.Parse(l2Rpc); safeErr != nil {
+				return safeErr
+			}
+			if safeFlags.IsSet() && txFlags.Unsigned() {
+				return errors.New("--unsigned-out cannot be used with --safe, the Safe transaction is proposed instead")
+			}
+			if safeFlags.IsSet() && safe.OperationType(safeFlags.Operation) != safe.Call {
+				return errors.New("--safe-operation is not supported, withdrawals are proposed as a Call")
+			}
+
+			if txErr := txFlags.Parse(); txErr != nil {
+				return txErr
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := txFlags.Key(keyFile, password)
+			if err != nil {
+				return err
+			}
+
+			l2Client, err := ethclient.Dial(l2Rpc)
+			if err != nil {
+				return err
+			}
+
+			var calldata []byte
+			var contract common.Address
+			value := big.NewInt(0)
+			if target != (common.Address{}) {
+				fmt.Println("Passing a message with", amount.String(), "wei to", target.Hex(), "on L1")
+				calldata, err = GetInitiateWithdrawalCalldata(target, new(big.Int).SetUint64(uint64(minGasLimit)), data)
+				contract = L2ToL1MessagePasserAddress
+				value = amount
+			} else {
+				fmt.Println("Withdrawing", amount.String(), "of", token.Hex(), "to", to.Hex(), "on L1")
+				calldata, err = GetWithdrawToCalldata(token, to, amount, minGasLimit, extraData)
+				contract = bridgeAddress
+				if token == LegacyERC20ETH {
+					value = amount
+				}
+			}
+			if err != nil {
+				return err
+			}
+
+			if safeFlags.IsSet() {
+				return safe.CreateSafeProposal(l2Client, key, safeFlags.Address, contract, calldata, value, safeFlags.Api, safe.Call, safeFlags.Nonce)
+			}
+
+			transaction, err := transaction.Send(context.Background(), l2Client, key, contract, value, calldata, txFlags)
+			if err != nil {
+				return err
+			}
+			if !txFlags.Unsigned() {
+				fmt.Println("Transaction sent:", transaction.Hash().Hex())
+			}
+			fmt.Println("Prove it with `withdraw prove` once a dispute game covers its L2 block")
+
+			return nil
+		},
+	}
+
+	initiateCmd.Flags().StringVar(&password, "password", "", "Password to decrypt the keyfile with")
+	initiateCmd.Flags().StringVar(&keyFile, "keyfile", "", "Keyfile to sign transaction with")
+	initiateCmd.Flags().StringVar(&l2Rpc, "l2-rpc", "", "L2 RPC URL")
+	initiateCmd.Flags().StringVar(&bridgeRaw, "bridge", L2StandardBridgeAddress.Hex(), "L2StandardBridge address")
+	initiateCmd.Flags().StringVar(&tokenRaw, "token", "", "OptimismMintableERC20 token to withdraw (optional, defaults to ETH)")
+	initiateCmd.Flags().StringVar(&toRaw, "to", "", "Recipient address on L1")
+	initiateCmd.Flags().StringVar(&amountRaw, "amount", "", "Amount to withdraw, in wei for ETH")
+	initiateCmd.Flags().Uint32Var(&minGasLimit, "min-gas-limit", DefaultWithdrawalMinGasLimit, "Minimum gas limit of the withdrawal on L1, or gas limit of the message with --target")
+	initiateCmd.Flags().StringVar(&extraDataRaw, "extra-data", "", "Hex data forwarded to the recipient with the withdrawal (optional)")
+	initiateCmd.Flags().StringVar(&targetRaw, "target", "", "Pass a message to this L1 address with L2ToL1MessagePasser instead of using the bridge (optional)")
+	initiateCmd.Flags().StringVar(&dataRaw, "data", "", "Hex calldata of the message to --target (optional)")
+	safeFlags.AddFlags(initiateCmd)
+	txFlags.AddFlags(initiateCmd)
+
+	return initiateCmd
+}
+
+// withdrawalFlags are the flags shared by the commands acting on an initiated withdrawal
+type withdrawalFlags struct {
+	l1Rpc, l2Rpc, portalRaw string
+	withdrawalIndex         int
+	portal                  common.Address
+	txHash                  common.Hash
+}
+
+func (f *withdrawalFlags) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.l1Rpc, "l1-rpc", "", "L1 RPC URL")
+	cmd.Flags().StringVar(&f.l2Rpc, "l2-rpc", "", "L2 RPC URL")
+	cmd.Flags().StringVar(&f.portalRaw, "portal", "", "OptimismPortal address on L1")
+}
+
+func (f *withdrawalFlags) parse(args []string) error {
+	if len(args) != 1 || len(common.FromHex(args[0])) != common.HashLength {
+		return errors.New("the L2 transaction hash of the withdrawal is required")
+	}
+	f.txHash = common.HexToHash(args[0])
+
+	if f.l1Rpc == "" {
+		return errors.New("l1-rpc is required")
+	}
+
+	if f.l2Rpc == "" {
+		return errors.New("l2-rpc is required")
+	}
+
+	if !common.IsHexAddress(f.portalRaw) {
+		return errors.New("invalid OptimismPortal address")
+	}
+	f.portal = common.HexToAddress(f.portalRaw)
+
+	return nil
+}
+
+func CreateWithdrawProveCommand() *cobra.Command {
+	var keyFile, password string
+	flags := &withdrawalFlags{}
+	txFlags := &transaction.Flags{}
+
+	proveCmd := &cobra.Command{
+		Use:   "prove <l2-tx-hash>",
+		Short: "Prove a withdrawal on L1",
+		Long: `Prove a withdrawal on L1 with OptimismPortal.proveWithdrawalTransaction
+
+The withdrawal is proven against the latest dispute game of the respected game type covering its L2
+block, with an eth_getProof storage proof of L2ToL1MessagePasser at the block of the game.`,
+		Args: cobra.ExactArgs(1),
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := flags.parse(args); err != nil {
+				return err
+			}
+
+			if keyFile == "" && !txFlags.Unsigned() {
+				return errors.New("keyfile is required")
+			}
+
+			if txErr := txFlags.Parse(); txErr != nil {
+				return txErr
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := txFlags.Key(keyFile, password)
+			if err != nil {
+				return err
+			}
+
+			l1Client, err := ethclient.Dial(flags.l1Rpc)
+			if err != nil {
+				return err
+			}
+
+			l2Client, err := ethclient.Dial(flags.l2Rpc)
+			if err != nil {
+				return err
+			}
+
+			withdrawal, err := GetWithdrawal(l2Client, flags.txHash, flags.withdrawalIndex)
+			if err != nil {
+				return err
+			}
+			fmt.Println("Proving withdrawal", withdrawal.Hash.Hex())
+
+			transaction, err := ProveWithdrawal(key, l1Client, l2Client, flags.portal, withdrawal, txFlags)
+			if err != nil {
+				return err
+			}
+			if !txFlags.Unsigned() {
+				fmt.Println("Transaction sent:", transaction.Hash().Hex())
+			}
+
+			return nil
+		},
+	}
+
+	proveCmd.Flags().StringVar(&password, "password", "", "Password to decrypt the keyfile with")
+	proveCmd.Flags().StringVar(&keyFile, "keyfile", "", "Keyfile to sign transaction with")
+	flags.addFlags(proveCmd)
+	proveCmd.Flags().IntVar(&flags.withdrawalIndex, "withdrawal-index", 0, "Index of the withdrawal among those of the transaction")
+	txFlags.AddFlags(proveCmd)
+
+	return proveCmd
+}
+
+func CreateWithdrawFinalizeCommand() *cobra.Command {
+	var keyFile, password, proofSubmitterRaw string
+	var proofSubmitter common.Address
+	flags := &withdrawalFlags{}
+	txFlags := &transaction.Flags{}
+
+	finalizeCmd := &cobra.Command{
+		Use:   "finalize <l2-tx-hash>",
+		Short: "Finalize a proven withdrawal on L1",
+		Long: `Finalize a proven withdrawal on L1 with OptimismPortal.finalizeWithdrawalTransaction
+
+By default, the withdrawal is finalized with the first proof that is past its maturity delay. If
+that proof was not submitted by the keyfile account, or with --proof-submitter,
+finalizeWithdrawalTransactionExternalProof is used.`,
+		Args: cobra.ExactArgs(1),
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := flags.parse(args); err != nil {
+				return err
+			}
+
+			if proofSubmitterRaw != "" {
+				if !common.IsHexAddress(proofSubmitterRaw) {
+					return errors.New("invalid proof submitter address")
+				}
+				proofSubmitter = common.HexToAddress(proofSubmitterRaw)
+			}
+
+			if keyFile == "" && !txFlags.Unsigned() {
+				return errors.New("keyfile is required")
+			}
+
+			if txErr := txFlags.Parse(); txErr != nil {
+				return txErr
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := txFlags.Key(keyFile, password)
+			if err != nil {
+				return err
+			}
+
+			l1Client, err := ethclient.Dial(flags.l1Rpc)
+			if err != nil {
+				return err
+			}
+
+			l2Client, err := ethclient.Dial(flags.l2Rpc)
+			if err != nil {
+				return err
+			}
+
+			withdrawal, err := GetWithdrawal(l2Client, flags.txHash, flags.withdrawalIndex)
+			if err != nil {
+				return err
+			}
+			fmt.Println("Finalizing withdrawal", withdrawal.Hash.Hex())
+
+			transaction, err := FinalizeWithdrawal(key, l1Client, flags.portal, withdrawal, proofSubmitter, txFlags)
+			if err != nil {
+				return err
+			}
+			if !txFlags.Unsigned() {
+				fmt.Println("Transaction sent:", transaction.Hash().Hex())
+			}
+
+			return nil
+		},
+	}
+
+	finalizeCmd.Flags().StringVar(&password, "password", "", "Password to decrypt the keyfile with")
+	finalizeCmd.Flags().StringVar(&keyFile, "keyfile", "", "Keyfile to sign transaction with")
+	finalizeCmd.Flags().StringVar(&proofSubmitterRaw, "proof-submitter", "", "Finalize with the proof of this account (optional)")
+	flags.addFlags(finalizeCmd)
+	finalizeCmd.Flags().IntVar(&flags.withdrawalIndex, "withdrawal-index", 0, "Index of the withdrawal among those of the transaction")
+	txFlags.AddFlags(finalizeCmd)
+
+	return finalizeCmd
+}
+
+func CreateWithdrawStatusCommand() *cobra.Command {
+	flags := &withdrawalFlags{}
+
+	statusCmd := &cobra.Command{
+		Use:   "status <l2-tx-hash>",
+		Short: "Show where the withdrawals of an L2 transaction stand",
+		Args:  cobra.ExactArgs(1),
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return flags.parse(args)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			l1Client, err := ethclient.Dial(flags.l1Rpc)
+			if err != nil {
+				return err
+			}
+
+			l2Client, err := ethclient.Dial(flags.l2Rpc)
+			if err != nil {
+				return err
+			}
+
+			withdrawals, err := GetWithdrawals(l2Client, flags.txHash)
+			if err != nil {
+				return err
+			}
+
+			for i, withdrawal := range withdrawals {
+				state, stateErr := GetWithdrawalState(l1Client, flags.portal, withdrawal)
+				if stateErr != nil {
+					return stateErr
+				}
+
+				fmt.Printf("Withdrawal %d: %s\n", i, withdrawal.Hash.Hex())
+				fmt.Println("  From:", withdrawal.Transaction.Sender.Hex(), "at L2 block", withdrawal.BlockNumber)
+				fmt.Println("  To:", withdrawal.Transaction.Target.Hex())
+				fmt.Println("  Value:", withdrawal.Transaction.Value.String())
+				fmt.Println("  Status:", state.Status)
+				switch state.Status {
+				case WithdrawalStatusReadyToProve:
+					fmt.Println("  Dispute game:", state.Game.Index.String(), "(", state.Game.Proxy.Hex(), ") at L2 block", state.Game.L2BlockNumber)
+				case WithdrawalStatusInChallengePeriod, WithdrawalStatusReadyToFinalize:
+					fmt.Println("  Proven by:", state.ProofSubmitter.Hex(), "at", state.ProvenAt.UTC().Format(time.RFC3339))
+					fmt.Println("  Proof matures at:", state.MaturesAt.UTC().Format(time.RFC3339))
+				}
+			}
+
+			return nil
+		},
+	}
+
+	flags.addFlags(statusCmd)
+
+	return statusCmd
+}
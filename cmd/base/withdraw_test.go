@@ -0,0 +1,137 @@
+package base
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/G7DAO/bifrost/bindings/DisputeGameFactory"
+	"github.com/G7DAO/bifrost/bindings/L2ToL1MessagePasser"
+	"github.com/G7DAO/bifrost/bindings/OptimismPortal"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func messagePassedLog(t *testing.T, transaction OptimismPortal.TypesWithdrawalTransaction, withdrawalHash common.Hash) *types.Log {
+	messagePasserAbi, err := L2ToL1MessagePasser.L2ToL1MessagePasserMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	event := messagePasserAbi.Events["MessagePassed"]
+
+	data, err := event.Inputs.NonIndexed().Pack(transaction.Value, transaction.GasLimit, transaction.Data, withdrawalHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &types.Log{
+		Address: L2ToL1MessagePasserAddress,
+		Topics: []common.Hash{
+			event.ID,
+			common.BigToHash(transaction.Nonce),
+			common.BytesToHash(transaction.Sender.Bytes()),
+			common.BytesToHash(transaction.Target.Bytes()),
+		},
+		Data: data,
+	}
+}
+
+func TestGetWithdrawalsFromReceipt(t *testing.T) {
+	transaction := OptimismPortal.TypesWithdrawalTransaction{
+		Nonce:    new(big.Int).Lsh(big.NewInt(1), 240),
+		Sender:   L2StandardBridgeAddress,
+		Target:   common.HexToAddress("0x3154Cf16ccdb4C6d922629664174b904d80F2C35"),
+		Value:    big.NewInt(1_000_000_000_000_000),
+		GasLimit: big.NewInt(287_000),
+		Data:     []byte{0xd7, 0x64, 0xad, 0x0b},
+	}
+	withdrawalHash, err := HashWithdrawal(transaction)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	receipt := &types.Receipt{
+		BlockNumber: big.NewInt(1234),
+		Logs:        []*types.Log{{Address: L2StandardBridgeAddress}, messagePassedLog(t, transaction, withdrawalHash)},
+	}
+	withdrawals, err := GetWithdrawalsFromReceipt(receipt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(withdrawals) != 1 {
+		t.Fatalf("expected 1 withdrawal, got %d", len(withdrawals))
+	}
+	withdrawal := withdrawals[0]
+	if withdrawal.Hash != withdrawalHash || withdrawal.BlockNumber != 1234 {
+		t.Errorf("unexpected withdrawal %s at block %d", withdrawal.Hash.Hex(), withdrawal.BlockNumber)
+	}
+	if withdrawal.Transaction.Nonce.Cmp(transaction.Nonce) != 0 || withdrawal.Transaction.Target != transaction.Target || withdrawal.Transaction.GasLimit.Cmp(transaction.GasLimit) != 0 {
+		t.Errorf("unexpected withdrawal transaction %+v", withdrawal.Transaction)
+	}
+
+	receipt.Logs = []*types.Log{messagePassedLog(t, transaction, common.HexToHash("0x01"))}
+	if _, err := GetWithdrawalsFromReceipt(receipt); err == nil {
+		t.Error("expected an error on a withdrawal hash mismatch")
+	}
+
+	receipt.Logs = nil
+	if _, err := GetWithdrawalsFromReceipt(receipt); err == nil {
+		t.Error("expected an error without MessagePassed event")
+	}
+}
+
+func TestWithdrawalStorageSlot(t *testing.T) {
+	withdrawalHash := common.HexToHash("0xaf2d0ce0b2b9b1d9e0a8f5e5b7b7d0e5b2a1c4e9d1f3b5a7c9e1d3f5a7b9c1d3")
+
+	expected := crypto.Keccak256Hash(append(withdrawalHash.Bytes(), make([]byte, 32)...))
+	if slot := WithdrawalStorageSlot(withdrawalHash); slot != expected {
+		t.Errorf("expected slot %s, got %s", expected.Hex(), slot.Hex())
+	}
+}
+
+func TestComputeOutputRoot(t *testing.T) {
+	proof := OptimismPortal.TypesOutputRootProof{
+		StateRoot:                common.HexToHash("0x01"),
+		MessagePasserStorageRoot: common.HexToHash("0x02"),
+		LatestBlockhash:          common.HexToHash("0x03"),
+	}
+
+	var preimage []byte
+	preimage = append(preimage, make([]byte, 32)...)
+	preimage = append(preimage, proof.StateRoot[:]...)
+	preimage = append(preimage, proof.MessagePasserStorageRoot[:]...)
+	preimage = append(preimage, proof.LatestBlockhash[:]...)
+	if outputRoot := ComputeOutputRoot(proof); outputRoot != crypto.Keccak256Hash(preimage) {
+		t.Errorf("unexpected output root %s", outputRoot.Hex())
+	}
+}
+
+func TestParseGameSearchResult(t *testing.T) {
+	proxy := common.HexToAddress("0x2Fc99fd16D8D3F6F66d164aA84E244c567E58A3d")
+
+	// gameType (4 bytes) ‖ timestamp (8 bytes) ‖ proxy (20 bytes)
+	var metadata [32]byte
+	metadata[3] = 1
+	copy(metadata[4:12], common.LeftPadBytes(big.NewInt(1_760_000_000).Bytes(), 8))
+	copy(metadata[12:], proxy.Bytes())
+
+	result := DisputeGameFactory.IDisputeGameFactoryGameSearchResult{
+		Index:     big.NewInt(42),
+		Metadata:  metadata,
+		RootClaim: common.HexToHash("0xbeef"),
+		ExtraData: common.LeftPadBytes(big.NewInt(32_000_000).Bytes(), 32),
+	}
+
+	game, err := parseGameSearchResult(result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if game.Proxy != proxy || game.L2BlockNumber != 32_000_000 || game.Index.Int64() != 42 || game.RootClaim != result.RootClaim {
+		t.Errorf("unexpected game %+v", game)
+	}
+
+	result.ExtraData = []byte{0x01}
+	if _, err := parseGameSearchResult(result); err == nil {
+		t.Error("expected an error on short extra data")
+	}
+}
@@ -0,0 +1,112 @@
+package cctp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	AttestationApiMainnet = "https://iris-api.circle.com"
+	AttestationApiTestnet = "https://iris-api-sandbox.circle.com"
+
+	AttestationStatusComplete = "complete"
+
+	DefaultAttestationPollInterval = 10 * time.Second
+	DefaultAttestationTimeout      = 30 * time.Minute
+)
+
+// DefaultAttestationApi returns Circle's attestation service for the network of the source chain
+func DefaultAttestationApi(sourceChainID uint64) string {
+	if IsTestnet(sourceChainID) {
+		return AttestationApiTestnet
+	}
+	return AttestationApiMainnet
+}
+
+// Attestation is the response of the attestation service for a message hash
+type Attestation struct {
+	Attestation string `json:"attestation"`
+	Status      string `json:"status"`
+}
+
+// Bytes returns the attestation signatures, once the status is complete
+func (a *Attestation) Bytes() []byte {
+	return common.FromHex(a.Attestation)
+}
+
+// FetchAttestation fetches the attestation of the message hash. A message that the attestation
+// service has not seen yet is reported with an empty status rather than an error.
+func FetchAttestation(ctx context.Context, httpClient *http.Client, attestationApi string, messageHash common.Hash) (*Attestation, error) {
+	requestURL := strings.TrimSuffix(attestationApi, "/") + "/attestations/" + messageHash.Hex()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch attestation: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read attestation response: %v", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return &Attestation{}, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("attestation service returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var attestation Attestation
+	if err := json.Unmarshal(body, &attestation); err != nil {
+		return nil, fmt.Errorf("failed to parse attestation response: %v", err)
+	}
+	return &attestation, nil
+}
+
+// WaitForAttestation polls the attestation service every pollInterval until the attestation of the
+// message hash is complete or the timeout expires
+func WaitForAttestation(ctx context.Context, attestationApi string, messageHash common.Hash, pollInterval time.Duration, timeout time.Duration) (*Attestation, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	httpClient := &http.Client{Timeout: 30 * time.Second}
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		attestation, err := FetchAttestation(ctx, httpClient, attestationApi, messageHash)
+		if err != nil && ctx.Err() == nil {
+			// The attestation service is polled for a while, a single failed request is not fatal
+			fmt.Println("Failed to fetch attestation, retrying:", err.Error())
+		} else if err == nil {
+			if attestation.Status == AttestationStatusComplete {
+				return attestation, nil
+			}
+
+			status := attestation.Status
+			if status == "" {
+				status = "not found yet"
+			}
+			fmt.Println("Attestation status:", status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out waiting for attestation of message %s", messageHash.Hex())
+		case <-ticker.C:
+		}
+	}
+}
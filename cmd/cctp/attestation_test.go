@@ -0,0 +1,138 @@
+package cctp
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/G7DAO/bifrost/bindings/MessageTransmitter"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestWaitForAttestation(t *testing.T) {
+	messageHash := common.HexToHash("0x2222222222222222222222222222222222222222222222222222222222222222")
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/attestations/"+messageHash.Hex() {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		switch atomic.AddInt32(&requests, 1) {
+		case 1:
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `{"error": "Message hash not found"}`)
+		case 2:
+			io.WriteString(w, `{"attestation": "PENDING", "status": "pending_confirmations"}`)
+		default:
+			io.WriteString(w, `{"attestation": "0xabcd", "status": "complete"}`)
+		}
+	}))
+	defer server.Close()
+
+	attestation, err := WaitForAttestation(context.Background(), server.URL, messageHash, time.Millisecond, time.Second)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if common.Bytes2Hex(attestation.Bytes()) != "abcd" {
+		t.Errorf("unexpected attestation %s", attestation.Attestation)
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 requests, got %d", requests)
+	}
+}
+
+func TestWaitForAttestationTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `{"attestation": "PENDING", "status": "pending_confirmations"}`)
+	}))
+	defer server.Close()
+
+	if _, err := WaitForAttestation(context.Background(), server.URL, common.Hash{}, time.Millisecond, 20*time.Millisecond); err == nil {
+		t.Fatal("expected a timeout error")
+	}
+}
+
+func TestParseMessage(t *testing.T) {
+	raw := common.FromHex("00000000" + // version
+		"00000000" + // source domain
+		"00000003" + // destination domain
+		"0000000000000102" + // nonce
+		"000000000000000000000000bd3fa81b58ba92a82136038b25adec7066af3155" + // sender
+		"00000000000000000000000019330d10d9cc8751218eaf51e8885d058642e08a" + // recipient
+		"0000000000000000000000000000000000000000000000000000000000000000" + // destination caller
+		"deadbeef") // body
+
+	message, err := ParseMessage(raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if message.SourceDomain != 0 || message.DestinationDomain != 3 || message.Nonce != 258 {
+		t.Errorf("unexpected header %+v", message)
+	}
+	if common.BytesToAddress(message.Recipient[12:]) != common.HexToAddress("0x19330d10D9Cc8751218eaf51E8885D058642E08A") {
+		t.Errorf("unexpected recipient 0x%x", message.Recipient)
+	}
+	if common.Bytes2Hex(message.Body) != "deadbeef" {
+		t.Errorf("unexpected body 0x%x", message.Body)
+	}
+
+	// keccak256(abi.encodePacked(uint32(0), uint64(258)))
+	expectedNonceHash := crypto.Keccak256Hash(common.FromHex("000000000000000000000102"))
+	if common.Hash(message.NonceHash()) != expectedNonceHash {
+		t.Errorf("unexpected nonce hash 0x%x", message.NonceHash())
+	}
+
+	if _, err := ParseMessage(raw[:messageHeaderLength-1]); err == nil {
+		t.Error("expected an error for a truncated message")
+	}
+
+	v2Raw := append(common.FromHex("00000001"), raw[4:]...)
+	if _, err := ParseMessage(v2Raw); err == nil || !strings.Contains(err.Error(), "--v2") {
+		t.Errorf("expected an error pointing to --v2 for a V2 message, got %v", err)
+	}
+}
+
+func TestGetMessagesFromReceipt(t *testing.T) {
+	transmitterAbi, err := MessageTransmitter.MessageTransmitterMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	messageSentEvent := transmitterAbi.Events["MessageSent"]
+
+	transmitter := common.HexToAddress("0x0a992d191DEeC32aFe36203Ad87D7d289a738F81")
+	transmitterV2 := common.HexToAddress("0x81D40F21F12A8F0E3252Bccb954D722d4c464B64")
+	messageSentLog := func(address common.Address, version string, nonce string) *types.Log {
+		raw := common.FromHex(version + "00000000" + "00000003" + nonce + strings.Repeat("00", 96) + "deadbeef")
+		data, packErr := messageSentEvent.Inputs.Pack(raw)
+		if packErr != nil {
+			t.Fatal(packErr)
+		}
+		return &types.Log{Address: address, Topics: []common.Hash{messageSentEvent.ID}, Data: data}
+	}
+
+	receipt := &types.Receipt{Logs: []*types.Log{
+		messageSentLog(transmitterV2, "00000001", "0000000000000000"),
+		messageSentLog(transmitter, "00000000", "0000000000000102"),
+	}}
+	messages, err := GetMessagesFromReceipt(receipt, transmitter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(messages) != 1 || messages[0].Nonce != 258 {
+		t.Errorf("expected only the message of the transmitter, got %+v", messages)
+	}
+
+	// A V2 burn only has messages of MessageTransmitterV2
+	receipt = &types.Receipt{Logs: []*types.Log{messageSentLog(transmitterV2, "00000001", "0000000000000000")}}
+	if _, err := GetMessagesFromReceipt(receipt, transmitter); err == nil || !strings.Contains(err.Error(), "--v2") {
+		t.Errorf("expected an error pointing to --v2, got %v", err)
+	}
+}
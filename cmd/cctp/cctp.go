@@ -1,59 +1,136 @@
 package cctp
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
+	"net/http"
+	"strings"
+	"time"
 
+	"github.com/G7DAO/bifrost/bindings/ERC20"
 	"github.com/G7DAO/bifrost/bindings/TokenMessenger"
+	"github.com/G7DAO/bifrost/cmd/safe"
+	"github.com/G7DAO/bifrost/cmd/transaction"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/spf13/cobra"
 )
 
-type ChainDomain uint32
+// GetDepositForBurnCalldata packs depositForBurn, or depositForBurnWithCaller if destinationCaller
+// is set, in which case only destinationCaller can receive the message on the destination domain
+func GetDepositForBurnCalldata(mintRecipient [32]byte, domain uint32, amount *big.Int, token common.Address, destinationCaller [32]byte) ([]byte, error) {
+	abi, err := TokenMessenger.TokenMessengerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
 
-const (
-	ChainDomainEthereum = 0
-	ChainDomainArbitrum = 3
-)
+	if destinationCaller != ([32]byte{}) {
+		return abi.Pack("depositForBurnWithCaller", amount, domain, mintRecipient, token, destinationCaller)
+	}
+	return abi.Pack("depositForBurn", amount, domain, mintRecipient, token)
+}
+
+// BurnCalldataBuilder packs the depositForBurn call of one message burning amount, so that V1 and
+// V2 burns share the preflight, approval and splitting logic
+type BurnCalldataBuilder func(amount *big.Int) ([]byte, error)
+
+// cctpBridge checks the balance, allowance and burn limit of the keyfile account, approves the
+// TokenMessenger if needed and sends one depositForBurn per message
+func cctpBridge(key *keystore.Key, client *ethclient.Client, amount *big.Int, token common.Address, contractAddress common.Address, split bool, buildBurn BurnCalldataBuilder, txFlags *transaction.Flags) error {
+	preflight, err := GetBurnPreflight(client, contractAddress, token, key.Address)
+	if err != nil {
+		return err
+	}
+
+	if err := preflight.CheckBalance(amount); err != nil {
+		return err
+	}
+
+	amounts, err := preflight.GetBurnAmounts(amount, split)
+	if err != nil {
+		return err
+	}
+	if txFlags.Unsigned() && len(amounts) > 1 {
+		return fmt.Errorf("--unsigned-out writes a single transaction, send at most the burn limit per message of %s", preflight.BurnLimit.String())
+	}
+
+	if preflight.NeedsApproval(amount) {
+		fmt.Println("Allowance of", contractAddress.Hex(), "is", preflight.Allowance.String(), "approving", amount.String())
+		if err := transaction.Approve(context.Background(), client, key, token, contractAddress, amount, txFlags); err != nil {
+			return err
+		}
+	}
 
-// String returns the string representation of the ChainDomain
-func (o ChainDomain) String() string {
-	switch o {
-	case ChainDomainEthereum:
-		return "Ethereum"
-	case ChainDomainArbitrum:
-		return "Arbitrum"
-	default:
-		return "Unknown"
+	for i, burnAmount := range amounts {
+		packed, err := buildBurn(burnAmount)
+		if err != nil {
+			return err
+		}
+
+		tx, err := transaction.Send(context.Background(), client, key, contractAddress, big.NewInt(0), packed, txFlags)
+		if err != nil {
+			return err
+		}
+
+		if len(amounts) > 1 {
+			fmt.Printf("Message %d/%d, amount %s\n", i+1, len(amounts), burnAmount.String())
+		}
+		if !txFlags.Unsigned() {
+			fmt.Println("Transaction hash:", tx.Hash().Hex())
+		}
 	}
+	return nil
 }
 
-func cctpBridge(key *keystore.Key, client *ethclient.Client, recipient common.Address, domain uint32, amount *big.Int, token common.Address, contractAddress common.Address) error {
-	mintRecipient, err := ParseMintRecipientFrom20BytesTo32Bytes(recipient)
+// cctpBridgePropose proposes approve + depositForBurn to the Safe as a single MultiSend batch, so
+// that the burn can never be executed without its allowance. Amounts above the burn limit are split
+// into several depositForBurn calls of the same batch.
+func cctpBridgePropose(key *keystore.Key, client *ethclient.Client, amount *big.Int, token common.Address, contractAddress common.Address, split bool, buildBurn BurnCalldataBuilder, safeFlags *safe.Flags, multiSendAddress common.Address) error {
+	preflight, err := GetBurnPreflight(client, contractAddress, token, safeFlags.Address)
 	if err != nil {
 		return err
 	}
 
-	abi, err := TokenMessenger.TokenMessengerMetaData.GetAbi()
+	// The Safe may be funded before the proposal is executed, so a short balance is only a warning
+	if balanceErr := preflight.CheckBalance(amount); balanceErr != nil {
+		fmt.Println("Warning:", balanceErr.Error(), "- the proposal will revert unless the Safe is funded before execution")
+	}
+
+	amounts, err := preflight.GetBurnAmounts(amount, split)
 	if err != nil {
 		return err
 	}
 
-	packed, err := abi.Pack("depositForBurn", amount, domain, mintRecipient, token)
+	erc20Abi, err := ERC20.ERC20MetaData.GetAbi()
 	if err != nil {
 		return err
 	}
 
-	tx, err := SendTransaction(client, key, packed, contractAddress.Hex(), big.NewInt(0))
+	approveData, err := erc20Abi.Pack("approve", contractAddress, amount)
 	if err != nil {
 		return err
 	}
 
-	fmt.Println("Transaction hash:", tx.Hash().Hex())
-	return nil
+	transactions := []safe.MultiSendTransaction{
+		{Operation: safe.Call, To: token, Value: big.NewInt(0), Data: approveData},
+	}
+	for _, burnAmount := range amounts {
+		depositForBurnData, err := buildBurn(burnAmount)
+		if err != nil {
+			return err
+		}
+		transactions = append(transactions, safe.MultiSendTransaction{Operation: safe.Call, To: contractAddress, Value: big.NewInt(0), Data: depositForBurnData})
+	}
+
+	multiSendData, err := safe.GetMultiSendCalldata(transactions)
+	if err != nil {
+		return err
+	}
+
+	return safe.CreateSafeProposal(client, key, safeFlags.Address, multiSendAddress, multiSendData, big.NewInt(0), safeFlags.Api, safe.DelegateCall, safeFlags.Nonce)
 }
 
 // CCTP uses 32 bytes addresses, while EVEM uses 20 bytes addresses
@@ -74,25 +151,59 @@ func ParseMintRecipientFrom20BytesTo32Bytes(recipient common.Address) ([32]byte,
 }
 
 func CreateCctpCommand() *cobra.Command {
-	var keyFile, password, rpc, recipientRaw, amountRaw, tokenRaw, contractRaw string
-	var domain uint32
-	var token, recipient, contract common.Address
-	var amount *big.Int
+	var keyFile, password, rpc, recipientRaw, destinationCallerRaw, amountRaw, tokenRaw, contractRaw, multiSendRaw, domainRaw string
+	var maxFeeRaw, feeApi, hookSignature, hookDataRaw string
+	var hookArgs []string
+	var domain, minFinalityThreshold uint32
+	var recipientIsTokenAccount, split, v2, fast bool
+	var mintRecipient, destinationCaller [32]byte
+	var token, contract, multiSend common.Address
+	var amount, maxFee *big.Int
+	var hookData []byte
+	var feeQuote *FeeQuote
+	safeFlags := &safe.Flags{}
+	txFlags := &transaction.Flags{}
 
 	cctpCmd := &cobra.Command{
 		Use:   "cctp",
 		Short: "Bifrost for CCTP cross-chain messaging protocol",
-		Long:  `Bifrost for CCTP cross-chain messaging protocol`,
+		Long: `Bifrost for CCTP cross-chain messaging protocol
+
+Burns with TokenMessenger (CCTP V1) by default. With --v2, burns with TokenMessengerV2, which supports
+fast transfers attested before finality for a fee and hook data executed on the destination domain.`,
 
 		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if domainRaw == "" {
+				return errors.New("domain is required")
+			}
+			destinationDomain, domainErr := LookupDomain(domainRaw)
+			if domainErr != nil {
+				return domainErr
+			}
+			domain = uint32(destinationDomain.ID)
 
-			if !common.IsHexAddress(recipientRaw) {
-				return errors.New("invalid recipient address")
+			testnet := false
+			if destinationDomain.ID == ChainDomainSolana && !recipientIsTokenAccount {
+				// The USDC mint, and so the token account of the recipient, differs between mainnet and devnet
+				chainID, chainIDErr := GetRpcChainID(rpc)
+				if chainIDErr != nil {
+					return chainIDErr
+				}
+				testnet = IsTestnet(chainID)
 			}
-			recipient = common.HexToAddress(recipientRaw)
 
-			if ChainDomain(domain).String() == "Unknown" {
-				return errors.New("invalid domain")
+			var recipientErr error
+			mintRecipient, recipientErr = ParseMintRecipient(recipientRaw, destinationDomain.ID, testnet, recipientIsTokenAccount)
+			if recipientErr != nil {
+				return recipientErr
+			}
+
+			if destinationCallerRaw != "" {
+				var destinationCallerErr error
+				destinationCaller, destinationCallerErr = ParseDestinationAddress(destinationCallerRaw, destinationDomain.ID)
+				if destinationCallerErr != nil {
+					return fmt.Errorf("invalid destination caller: %v", destinationCallerErr)
+				}
 			}
 
 			if amountRaw == "" {
@@ -104,27 +215,143 @@ func CreateCctpCommand() *cobra.Command {
 					return errors.New("invalid amount")
 				}
 			}
-			if tokenRaw == "" {
-				return errors.New("token is required")
-			} else {
-				token = common.HexToAddress(tokenRaw)
+			if !v2 && (fast || minFinalityThreshold != 0 || maxFeeRaw != "" || feeApi != "" || hookSignature != "" || len(hookArgs) > 0 || hookDataRaw != "") {
+				return errors.New("--fast, --min-finality-threshold, --max-fee, --fee-api and the hook flags require --v2")
 			}
-			if contractRaw == "" {
-				return errors.New("contract is required")
-			} else {
-				contract = common.HexToAddress(contractRaw)
+
+			var sourceDomain *Domain
+			var sourceContracts *DomainContracts
+			if tokenRaw == "" || contractRaw == "" || (v2 && maxFeeRaw == "") {
+				var sourceErr error
+				sourceDomain, sourceContracts, sourceErr = GetRpcDomain(rpc)
+				if sourceErr != nil {
+					if v2 {
+						return fmt.Errorf("%v, --token, --contract and --max-fee are required", sourceErr)
+					}
+					return fmt.Errorf("%v, --token and --contract are required", sourceErr)
+				}
+				if sourceDomain.ID == destinationDomain.ID {
+					return errors.New("destination domain must differ from the source domain")
+				}
+				if v2 && !sourceDomain.V2 {
+					return fmt.Errorf("%s does not support CCTP V2", sourceDomain.Name)
+				}
+				if tokenRaw == "" {
+					tokenRaw = sourceContracts.USDC.Hex()
+					fmt.Println("--token not specified, using USDC on", sourceDomain.Name, "(", tokenRaw, ")")
+				}
+				if contractRaw == "" && v2 {
+					tokenMessengerV2, _ := V2Contracts(IsTestnet(sourceContracts.ChainID))
+					contractRaw = tokenMessengerV2.Hex()
+					fmt.Println("--contract not specified, using TokenMessengerV2 on", sourceDomain.Name, "(", contractRaw, ")")
+				} else if contractRaw == "" {
+					contractRaw = sourceContracts.TokenMessenger.Hex()
+					fmt.Println("--contract not specified, using TokenMessenger on", sourceDomain.Name, "(", contractRaw, ")")
+				}
+			}
+			if !common.IsHexAddress(tokenRaw) {
+				return errors.New("invalid token address")
+			}
+			token = common.HexToAddress(tokenRaw)
+			if !common.IsHexAddress(contractRaw) {
+				return errors.New("invalid contract address")
+			}
+			contract = common.HexToAddress(contractRaw)
+
+			if v2 {
+				if !destinationDomain.V2 {
+					return fmt.Errorf("%s does not support CCTP V2", destinationDomain.Name)
+				}
+
+				if fast && minFinalityThreshold != 0 {
+					return errors.New("--fast and --min-finality-threshold cannot be used together")
+				}
+				if fast {
+					minFinalityThreshold = FinalityThresholdFast
+				} else if minFinalityThreshold == 0 {
+					minFinalityThreshold = FinalityThresholdStandard
+				}
+
+				if maxFeeRaw != "" {
+					if split {
+						return errors.New("--max-fee cannot be used with --split, the fee of each message is quoted instead")
+					}
+					var ok bool
+					maxFee, ok = new(big.Int).SetString(maxFeeRaw, 10)
+					if !ok || maxFee.Sign() < 0 {
+						return errors.New("invalid max fee")
+					}
+				} else {
+					if feeApi == "" {
+						feeApi = DefaultAttestationApi(sourceContracts.ChainID)
+					}
+					fmt.Println("--max-fee not specified, quoting fees from", feeApi)
+
+					quotes, quoteErr := FetchFeeQuotes(context.Background(), &http.Client{Timeout: 30 * time.Second}, feeApi, uint32(sourceDomain.ID), domain)
+					if quoteErr != nil {
+						return quoteErr
+					}
+					feeQuote, quoteErr = GetFeeQuote(quotes, minFinalityThreshold)
+					if quoteErr != nil {
+						return quoteErr
+					}
+					fmt.Println("Fee for finality threshold", minFinalityThreshold, "is", feeQuote.MinimumFee.String(), "bps")
+				}
+
+				if hookDataRaw != "" && (hookSignature != "" || len(hookArgs) > 0) {
+					return errors.New("--hook-data cannot be used with --hook-signature and --hook-arg")
+				}
+				if hookDataRaw != "" {
+					if !strings.HasPrefix(hookDataRaw, "0x") {
+						return errors.New("invalid hook data, expected 0x prefixed hex")
+					}
+					hookData = common.FromHex(hookDataRaw)
+				} else if hookSignature != "" {
+					var hookErr error
+					hookData, hookErr = EncodeHookData(hookSignature, hookArgs)
+					if hookErr != nil {
+						return fmt.Errorf("invalid hook: %v", hookErr)
+					}
+				} else if len(hookArgs) > 0 {
+					return errors.New("--hook-arg requires --hook-signature")
+				}
 			}
 
-			if keyFile == "" {
+			if keyFile == "" && !txFlags.Unsigned() {
 				return errors.New("keyfile is required")
 			}
 
+			if safeErr := safeFlags.Parse(rpc); safeErr != nil {
+				return safeErr
+			}
+			if safeFlags.IsSet() && txFlags.Unsigned() {
+				return errors.New("--unsigned-out cannot be used with --safe, the Safe transaction is proposed instead")
+			}
+
+			if txErr := txFlags.Parse(); txErr != nil {
+				return txErr
+			}
+
+			if safeFlags.IsSet() {
+				if safe.OperationType(safeFlags.Operation) != safe.Call {
+					return errors.New("--safe-operation is not supported, approve and depositForBurn are proposed as a MultiSend batch")
+				}
+
+				if !common.IsHexAddress(multiSendRaw) {
+					return errors.New("invalid MultiSend address")
+				}
+				multiSend = common.HexToAddress(multiSendRaw)
+			}
+
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			fmt.Println("Bridging to", recipientRaw)
+			if ChainDomain(domain) == ChainDomainSolana && !recipientIsTokenAccount {
+				fmt.Println("Minting to the USDC token account", FormatMintRecipient(mintRecipient, ChainDomainSolana))
+			}
 
-			key, err := TokenMessenger.KeyFromFile(keyFile, password)
+			key, err := txFlags.Key(keyFile, password)
 			if err != nil {
 				return err
 			}
@@ -134,17 +361,62 @@ func CreateCctpCommand() *cobra.Command {
 				return err
 			}
 
-			return cctpBridge(key, client, recipient, domain, amount, token, contract)
+			buildBurn := func(burnAmount *big.Int) ([]byte, error) {
+				return GetDepositForBurnCalldata(mintRecipient, domain, burnAmount, token, destinationCaller)
+			}
+			if v2 {
+				buildBurn = func(burnAmount *big.Int) ([]byte, error) {
+					burnMaxFee := maxFee
+					if feeQuote != nil {
+						var feeErr error
+						burnMaxFee, feeErr = feeQuote.MaxFee(burnAmount)
+						if feeErr != nil {
+							return nil, feeErr
+						}
+					}
+					if burnMaxFee.Cmp(burnAmount) >= 0 {
+						return nil, fmt.Errorf("max fee %s must be lower than the amount %s", burnMaxFee.String(), burnAmount.String())
+					}
+					fmt.Println("Max fee for", burnAmount.String(), "is", burnMaxFee.String())
+
+					return GetDepositForBurnV2Calldata(mintRecipient, domain, burnAmount, token, destinationCaller, burnMaxFee, minFinalityThreshold, hookData)
+				}
+			}
+
+			if safeFlags.IsSet() {
+				return cctpBridgePropose(key, client, amount, token, contract, split, buildBurn, safeFlags, multiSend)
+			}
+
+			return cctpBridge(key, client, amount, token, contract, split, buildBurn, txFlags)
 		},
 	}
 
 	cctpCmd.Flags().StringVar(&password, "password", "", "Password to encrypt accounts with")
 	cctpCmd.Flags().StringVar(&keyFile, "keyfile", "", "Keyfile to sign transaction with")
 	cctpCmd.Flags().StringVar(&rpc, "rpc", "", "RPC URL")
-	cctpCmd.Flags().StringVar(&recipientRaw, "recipient", "", "Recipient address in the destination domain")
-	cctpCmd.Flags().Uint32Var(&domain, "domain", 0, "Destination domain")
+	cctpCmd.Flags().StringVar(&recipientRaw, "recipient", "", "Recipient address in the destination domain: hex for EVM chains, Sui and Aptos, base58 for Solana, bech32 for Noble")
+	cctpCmd.Flags().BoolVar(&recipientIsTokenAccount, "recipient-token-account", false, "For Solana, mint to --recipient as a USDC token account instead of to the associated token account of the --recipient wallet")
+	cctpCmd.Flags().StringVar(&destinationCallerRaw, "destination-caller", "", "Only account allowed to receive the message on the destination domain (optional, defaults to anyone)")
+	cctpCmd.Flags().StringVar(&domainRaw, "domain", "", "Destination domain, by name (e.g. base) or CCTP domain ID")
 	cctpCmd.Flags().StringVar(&amountRaw, "amount", "", "Amount of tokens to send")
-	cctpCmd.Flags().StringVar(&tokenRaw, "token", "", "Token to send")
-	cctpCmd.Flags().StringVar(&contractRaw, "contract", "", "Contract to send tokens from")
+	cctpCmd.Flags().StringVar(&tokenRaw, "token", "", "Token to send (optional, defaults to USDC on the source chain)")
+	cctpCmd.Flags().StringVar(&contractRaw, "contract", "", "Contract to send tokens from (optional, defaults to the TokenMessenger on the source chain)")
+	cctpCmd.Flags().BoolVar(&split, "split", false, "Send amounts above the burn limit per message as several messages instead of failing")
+	cctpCmd.Flags().BoolVar(&v2, "v2", false, "Burn with CCTP V2 (TokenMessengerV2) instead of V1")
+	cctpCmd.Flags().BoolVar(&fast, "fast", false, "V2 only: fast transfer, attested before the burn is finalized for a fee")
+	cctpCmd.Flags().Uint32Var(&minFinalityThreshold, "min-finality-threshold", 0, "V2 only: minimum finality threshold of the attestation (optional, defaults to 2000 for standard transfers)")
+	cctpCmd.Flags().StringVar(&maxFeeRaw, "max-fee", "", "V2 only: maximum fee paid to Circle, in token units (optional, defaults to the fee quoted by --fee-api)")
+	cctpCmd.Flags().StringVar(&feeApi, "fee-api", "", "V2 only: fee API URL (optional, defaults to Circle's API for the source network)")
+	cctpCmd.Flags().StringVar(&hookSignature, "hook-signature", "", "V2 only: function signature of the hook executed on the destination domain, e.g. deposit(address,uint256)")
+	cctpCmd.Flags().StringArrayVar(&hookArgs, "hook-arg", nil, "V2 only: argument of --hook-signature, repeated once per argument in order")
+	cctpCmd.Flags().StringVar(&hookDataRaw, "hook-data", "", "V2 only: raw hex hook data, instead of --hook-signature and --hook-arg")
+	safeFlags.AddFlags(cctpCmd)
+	txFlags.AddFlags(cctpCmd)
+	cctpCmd.Flags().StringVar(&multiSendRaw, "safe-multisend", safe.MultiSendCallOnlyAddress, "MultiSendCallOnly contract used to batch Safe proposals")
+
+	cctpCmd.AddCommand(CreateReceiveCommand())
+	cctpCmd.AddCommand(CreateReplaceCommand())
+	cctpCmd.AddCommand(CreateHistoryCommand())
+
 	return cctpCmd
 }
@@ -0,0 +1,321 @@
+package cctp
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+type ChainDomain uint32
+
+const (
+	ChainDomainEthereum   ChainDomain = 0
+	ChainDomainAvalanche  ChainDomain = 1
+	ChainDomainOptimism   ChainDomain = 2
+	ChainDomainArbitrum   ChainDomain = 3
+	ChainDomainNoble      ChainDomain = 4
+	ChainDomainSolana     ChainDomain = 5
+	ChainDomainBase       ChainDomain = 6
+	ChainDomainPolygonPoS ChainDomain = 7
+	ChainDomainSui        ChainDomain = 8
+	ChainDomainAptos      ChainDomain = 9
+	ChainDomainUnichain   ChainDomain = 10
+)
+
+// String returns the string representation of the ChainDomain
+func (o ChainDomain) String() string {
+	if domain := DomainByID(o); domain != nil {
+		return domain.Name
+	}
+	return "Unknown"
+}
+
+// DomainContracts are the CCTP contracts deployed on one network of a domain
+type DomainContracts struct {
+	ChainID            uint64
+	TokenMessenger     common.Address
+	MessageTransmitter common.Address
+	USDC               common.Address
+	// NativeUSDC is the USDC of a non-EVM domain in its own address format, when the mint recipient
+	// is derived from it
+	NativeUSDC string
+}
+
+// Domain is a chain reachable through CCTP. Contracts are only listed for EVM domains: bifrost only
+// signs EVM transactions, so the other domains can only be used as a destination, and their programs
+// and modules are never called. They only list the NativeUSDC that ParseMintRecipient needs, e.g. the
+// mint whose associated token account receives USDC on Solana.
+type Domain struct {
+	ID      ChainDomain
+	Name    string
+	Aliases []string
+	EVM     bool
+	// V2 is set if the domain supports CCTP V2, whose contracts share the same addresses on every
+	// EVM domain
+	V2      bool
+	Mainnet *DomainContracts
+	Testnet *DomainContracts
+}
+
+// Source: https://developers.circle.com/stablecoins/evm-smart-contracts
+var Domains = []Domain{
+	{
+		ID:      ChainDomainEthereum,
+		Name:    "Ethereum",
+		Aliases: []string{"eth", "mainnet", "sepolia"},
+		EVM:     true,
+		V2:      true,
+		Mainnet: &DomainContracts{
+			ChainID:            1,
+			TokenMessenger:     common.HexToAddress("0xBd3fa81B58Ba92a82136038B25aDec7066af3155"),
+			MessageTransmitter: common.HexToAddress("0x0a992d191DEeC32aFe36203Ad87D7d289a738F81"),
+			USDC:               common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"),
+		},
+		Testnet: &DomainContracts{
+			ChainID:            11155111,
+			TokenMessenger:     common.HexToAddress("0x9f3B8679c73C2Fef8b59B4f3444d4e156fb70AA5"),
+			MessageTransmitter: common.HexToAddress("0x7865fAfC2db2093669d92c0F33AeEF291086BEFD"),
+			USDC:               common.HexToAddress("0x1c7D4B196Cb0C7B01d743Fbc6116a902379C7238"),
+		},
+	},
+	{
+		ID:      ChainDomainAvalanche,
+		Name:    "Avalanche",
+		Aliases: []string{"avax", "fuji"},
+		EVM:     true,
+		V2:      true,
+		Mainnet: &DomainContracts{
+			ChainID:            43114,
+			TokenMessenger:     common.HexToAddress("0x6B25532e1060CE10cc3B0A99e5683b91BFDe6982"),
+			MessageTransmitter: common.HexToAddress("0x8186359aF5F57FbB40c6b14A588d2A59C0C29880"),
+			USDC:               common.HexToAddress("0xB97EF9Ef8734C71904D8002F8b6Bc66Dd9c48a6E"),
+		},
+		Testnet: &DomainContracts{
+			ChainID:            43113,
+			TokenMessenger:     common.HexToAddress("0xeb08f243E5d3FCFF26A9E38Ae5520A669f4019d0"),
+			MessageTransmitter: common.HexToAddress("0xa9fB1b3009DCb79E2fe346c16a604B8Fa8aE0a79"),
+			USDC:               common.HexToAddress("0x5425890298aed601595a70AB815c96711a31Bc65"),
+		},
+	},
+	{
+		ID:      ChainDomainOptimism,
+		Name:    "OP",
+		Aliases: []string{"optimism", "op-sepolia"},
+		EVM:     true,
+		V2:      true,
+		Mainnet: &DomainContracts{
+			ChainID:            10,
+			TokenMessenger:     common.HexToAddress("0x2B4069517957735bE00ceE0fadAE88a26365528f"),
+			MessageTransmitter: common.HexToAddress("0x4D41f22c5a0e5c74090899E5a8Fb597a8842b3e8"),
+			USDC:               common.HexToAddress("0x0b2C639c533813f4Aa9D7837CAf62653d097Ff85"),
+		},
+		Testnet: &DomainContracts{
+			ChainID:            11155420,
+			TokenMessenger:     common.HexToAddress("0x9f3B8679c73C2Fef8b59B4f3444d4e156fb70AA5"),
+			MessageTransmitter: common.HexToAddress("0x7865fAfC2db2093669d92c0F33AeEF291086BEFD"),
+			USDC:               common.HexToAddress("0x5fd84259d66Cd46123540766Be93DFE6D43130D7"),
+		},
+	},
+	{
+		ID:      ChainDomainArbitrum,
+		Name:    "Arbitrum",
+		Aliases: []string{"arb", "arbitrum-sepolia"},
+		EVM:     true,
+		V2:      true,
+		Mainnet: &DomainContracts{
+			ChainID:            42161,
+			TokenMessenger:     common.HexToAddress("0x19330d10D9Cc8751218eaf51E8885D058642E08A"),
+			MessageTransmitter: common.HexToAddress("0xC30362313FBBA5cf9163F0bb16a0e01f01A896ca"),
+			USDC:               common.HexToAddress("0xaf88d065e77c8cC2239327C5EDb3A432268e5831"),
+		},
+		Testnet: &DomainContracts{
+			ChainID:            421614,
+			TokenMessenger:     common.HexToAddress("0x9f3B8679c73C2Fef8b59B4f3444d4e156fb70AA5"),
+			MessageTransmitter: common.HexToAddress("0xaCF1ceeF35caAc005e15888dDb8A3515C41B4872"),
+			USDC:               common.HexToAddress("0x75faf114eafb1BDbe2F0316DF893fd58CE46AA4d"),
+		},
+	},
+	{
+		ID:   ChainDomainNoble,
+		Name: "Noble",
+	},
+	{
+		ID:      ChainDomainSolana,
+		Name:    "Solana",
+		Aliases: []string{"sol"},
+		V2:      true,
+		// Source: https://developers.circle.com/stablecoins/usdc-contract-addresses
+		Mainnet: &DomainContracts{NativeUSDC: "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"},
+		Testnet: &DomainContracts{NativeUSDC: "4zMMC9srt5Ri5X14GAgXhaHii3GnPAEERYPJgZJDncDU"},
+	},
+	{
+		ID:      ChainDomainBase,
+		Name:    "Base",
+		Aliases: []string{"base-sepolia"},
+		EVM:     true,
+		V2:      true,
+		Mainnet: &DomainContracts{
+			ChainID:            8453,
+			TokenMessenger:     common.HexToAddress("0x1682Ae6375C4E4A97e4B583BC394c861A46D8962"),
+			MessageTransmitter: common.HexToAddress("0xAD09780d193884d503182aD4588450C416D6F9D4"),
+			USDC:               common.HexToAddress("0x833589fCD6eDb6E08f4c7C32D4f71b54bdA02913"),
+		},
+		Testnet: &DomainContracts{
+			ChainID:            84532,
+			TokenMessenger:     common.HexToAddress("0x9f3B8679c73C2Fef8b59B4f3444d4e156fb70AA5"),
+			MessageTransmitter: common.HexToAddress("0x7865fAfC2db2093669d92c0F33AeEF291086BEFD"),
+			USDC:               common.HexToAddress("0x036CbD53842c5426634e7929541eC2318f3dCF7e"),
+		},
+	},
+	{
+		ID:      ChainDomainPolygonPoS,
+		Name:    "Polygon PoS",
+		Aliases: []string{"polygon", "matic", "amoy"},
+		EVM:     true,
+		V2:      true,
+		Mainnet: &DomainContracts{
+			ChainID:            137,
+			TokenMessenger:     common.HexToAddress("0x9daF8c91AEFAE50b9c0E69629D3F6Ca40cA3B3FE"),
+			MessageTransmitter: common.HexToAddress("0xF3be9355363857F3e001be68856A2f96b4C39Ba9"),
+			USDC:               common.HexToAddress("0x3c499c542cEF5E3811e1192ce70d8cC03d5c3359"),
+		},
+		Testnet: &DomainContracts{
+			ChainID:            80002,
+			TokenMessenger:     common.HexToAddress("0x9f3B8679c73C2Fef8b59B4f3444d4e156fb70AA5"),
+			MessageTransmitter: common.HexToAddress("0x7865fAfC2db2093669d92c0F33AeEF291086BEFD"),
+			USDC:               common.HexToAddress("0x41E94Eb019C0762f9Bfcf9Fb1E58725BfB0e7582"),
+		},
+	},
+	{
+		ID:   ChainDomainSui,
+		Name: "Sui",
+	},
+	{
+		ID:   ChainDomainAptos,
+		Name: "Aptos",
+	},
+	{
+		ID:      ChainDomainUnichain,
+		Name:    "Unichain",
+		Aliases: []string{"unichain-sepolia"},
+		EVM:     true,
+		V2:      true,
+		Mainnet: &DomainContracts{
+			ChainID:            130,
+			TokenMessenger:     common.HexToAddress("0x4e744b28E787c3aD0e810eD65A24461D4ac5a762"),
+			MessageTransmitter: common.HexToAddress("0x353bE9E2E38AB1D19104534e4edC21c643Df86f4"),
+			USDC:               common.HexToAddress("0x078D782b760474a361dDA0AF3839290b0EF57AD6"),
+		},
+		Testnet: &DomainContracts{
+			ChainID:            1301,
+			TokenMessenger:     common.HexToAddress("0x8ed94B8dAd2Dc5453862ea5e316A8e71AAed9782"),
+			MessageTransmitter: common.HexToAddress("0xbc498c326533d675cf571B90A2Ced265ACb7d086"),
+			USDC:               common.HexToAddress("0x31d0220469e10c4E71834a79b1f276d740d3768F"),
+		},
+	},
+}
+
+// DomainByID returns the domain with the given CCTP domain ID, or nil if it is unknown
+func DomainByID(id ChainDomain) *Domain {
+	for i := range Domains {
+		if Domains[i].ID == id {
+			return &Domains[i]
+		}
+	}
+	return nil
+}
+
+// LookupDomain returns the domain matching a CCTP domain ID, a name or an alias, case insensitively
+func LookupDomain(nameOrID string) (*Domain, error) {
+	if id, err := strconv.ParseUint(nameOrID, 10, 32); err == nil {
+		if domain := DomainByID(ChainDomain(id)); domain != nil {
+			return domain, nil
+		}
+		return nil, fmt.Errorf("unknown domain %d", id)
+	}
+
+	normalized := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(nameOrID), " ", "-"))
+	for i := range Domains {
+		if strings.ToLower(strings.ReplaceAll(Domains[i].Name, " ", "-")) == normalized {
+			return &Domains[i], nil
+		}
+		for _, alias := range Domains[i].Aliases {
+			if alias == normalized {
+				return &Domains[i], nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("unknown domain %s, expected one of: %s", nameOrID, strings.Join(DomainNames(), ", "))
+}
+
+// DomainByChainID returns the domain and contracts of the EVM chain with the given chain ID
+func DomainByChainID(chainID uint64) (*Domain, *DomainContracts, error) {
+	for i := range Domains {
+		if !Domains[i].EVM {
+			continue
+		}
+		for _, contracts := range []*DomainContracts{Domains[i].Mainnet, Domains[i].Testnet} {
+			if contracts != nil && contracts.ChainID == chainID {
+				return &Domains[i], contracts, nil
+			}
+		}
+	}
+	return nil, nil, fmt.Errorf("chain %d is not a known CCTP domain", chainID)
+}
+
+// GetRpcChainID returns the chain ID of the chain behind the RPC
+func GetRpcChainID(rpc string) (uint64, error) {
+	client, err := ethclient.Dial(rpc)
+	if err != nil {
+		return 0, err
+	}
+
+	chainID, err := client.ChainID(context.Background())
+	if err != nil {
+		return 0, err
+	}
+
+	return chainID.Uint64(), nil
+}
+
+// GetRpcDomain returns the domain and CCTP contracts of the chain behind the RPC
+func GetRpcDomain(rpc string) (*Domain, *DomainContracts, error) {
+	chainID, err := GetRpcChainID(rpc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return DomainByChainID(chainID)
+}
+
+// IsTestnet returns true if the chain ID belongs to the testnet of a known domain
+func IsTestnet(chainID uint64) bool {
+	for _, domain := range Domains {
+		if domain.EVM && domain.Testnet != nil && domain.Testnet.ChainID == chainID {
+			return true
+		}
+	}
+	return false
+}
+
+// Contracts returns the contracts of the domain on mainnet or testnet, or nil if none are listed
+func (d *Domain) Contracts(testnet bool) *DomainContracts {
+	if testnet {
+		return d.Testnet
+	}
+	return d.Mainnet
+}
+
+// DomainNames lists the names of every known domain with their IDs
+func DomainNames() []string {
+	names := make([]string, len(Domains))
+	for i, domain := range Domains {
+		names[i] = fmt.Sprintf("%s (%d)", strings.ToLower(strings.ReplaceAll(domain.Name, " ", "-")), domain.ID)
+	}
+	return names
+}
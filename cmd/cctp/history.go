@@ -0,0 +1,432 @@
+package cctp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"text/tabwriter"
+
+	"github.com/G7DAO/bifrost/bindings/MessageTransmitter"
+	"github.com/G7DAO/bifrost/bindings/TokenMessenger"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/cobra"
+)
+
+const (
+	DefaultHistoryChunkSize = 5000
+
+	TransferStatusMinted  = "minted"
+	TransferStatusPending = "pending"
+	TransferStatusUnknown = "unknown"
+)
+
+// Transfer is a burn on the source domain reconciled with its mint on the destination domain
+type Transfer struct {
+	SourceDomain      uint32       `json:"sourceDomain"`
+	DestinationDomain uint32       `json:"destinationDomain"`
+	Nonce             uint64       `json:"nonce"`
+	BurnTxHash        common.Hash  `json:"burnTxHash"`
+	BurnBlock         uint64       `json:"burnBlock"`
+	BurnToken         string       `json:"burnToken"`
+	Amount            *big.Int     `json:"amount"`
+	MintRecipient     string       `json:"mintRecipient"`
+	Status            string       `json:"status"`
+	MintTxHash        *common.Hash `json:"mintTxHash,omitempty"`
+	MintedAmount      *big.Int     `json:"mintedAmount,omitempty"`
+
+	destinationTokenMessenger common.Address
+	burnTimestamp             uint64
+}
+
+// Mint is the reception of a message on the destination domain, with the tokens it minted
+type Mint struct {
+	TxHash common.Hash
+	Block  uint64
+	Amount *big.Int
+}
+
+// scanBlocks calls scan on consecutive ranges of at most chunkSize blocks between fromBlock and
+// toBlock, both inclusive, so that log queries stay under the limits of RPC providers
+func scanBlocks(fromBlock uint64, toBlock uint64, chunkSize uint64, scan func(start uint64, end uint64) error) error {
+	for start := fromBlock; start <= toBlock; start += chunkSize {
+		end := start + chunkSize - 1
+		if end > toBlock {
+			end = toBlock
+		}
+		if err := scan(start, end); err != nil {
+			return fmt.Errorf("failed to scan blocks %d to %d: %v", start, end, err)
+		}
+	}
+	return nil
+}
+
+// FetchBurns returns the DepositForBurn events of the depositor emitted by the TokenMessenger
+// between fromBlock and toBlock
+func FetchBurns(client *ethclient.Client, tokenMessengerAddress common.Address, depositor common.Address, fromBlock uint64, toBlock uint64, chunkSize uint64) ([]*TokenMessenger.TokenMessengerDepositForBurn, error) {
+	tokenMessenger, err := TokenMessenger.NewTokenMessengerFilterer(tokenMessengerAddress, client)
+	if err != nil {
+		return nil, err
+	}
+
+	var burns []*TokenMessenger.TokenMessengerDepositForBurn
+	err = scanBlocks(fromBlock, toBlock, chunkSize, func(start uint64, end uint64) error {
+		iterator, filterErr := tokenMessenger.FilterDepositForBurn(&bind.FilterOpts{Start: start, End: &end}, nil, nil, []common.Address{depositor})
+		if filterErr != nil {
+			return filterErr
+		}
+		defer iterator.Close()
+
+		for iterator.Next() {
+			burns = append(burns, iterator.Event)
+		}
+		return iterator.Error()
+	})
+	return burns, err
+}
+
+// FetchMints returns the mints of the messages of the source domain with the given nonces, found
+// from MessageTransmitter.MessageReceived events between fromBlock and toBlock and the
+// TokenMessenger.MintAndWithdraw event of the same transaction
+func FetchMints(client *ethclient.Client, transmitterAddress common.Address, tokenMessengerAddress common.Address, sourceDomain uint32, nonces []uint64, fromBlock uint64, toBlock uint64, chunkSize uint64) (map[uint64]*Mint, error) {
+	transmitter, err := MessageTransmitter.NewMessageTransmitterFilterer(transmitterAddress, client)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenMessenger, err := TokenMessenger.NewTokenMessengerFilterer(tokenMessengerAddress, client)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenMessengerAbi, err := TokenMessenger.TokenMessengerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	mintAndWithdrawID := tokenMessengerAbi.Events["MintAndWithdraw"].ID
+
+	mints := make(map[uint64]*Mint)
+	err = scanBlocks(fromBlock, toBlock, chunkSize, func(start uint64, end uint64) error {
+		iterator, filterErr := transmitter.FilterMessageReceived(&bind.FilterOpts{Start: start, End: &end}, nil, nonces)
+		if filterErr != nil {
+			return filterErr
+		}
+		defer iterator.Close()
+
+		for iterator.Next() {
+			received := iterator.Event
+			// Nonces are only unique per source domain
+			if received.SourceDomain != sourceDomain {
+				continue
+			}
+
+			mint := &Mint{TxHash: received.Raw.TxHash, Block: received.Raw.BlockNumber}
+			receipt, receiptErr := client.TransactionReceipt(context.Background(), received.Raw.TxHash)
+			if receiptErr != nil {
+				return fmt.Errorf("failed to fetch receipt of %s: %v", received.Raw.TxHash.Hex(), receiptErr)
+			}
+			for _, log := range receipt.Logs {
+				if log.Address != tokenMessengerAddress || len(log.Topics) == 0 || log.Topics[0] != mintAndWithdrawID {
+					continue
+				}
+				mintAndWithdraw, parseErr := tokenMessenger.ParseMintAndWithdraw(*log)
+				if parseErr != nil {
+					return parseErr
+				}
+				mint.Amount = mintAndWithdraw.Amount
+			}
+
+			mints[received.Nonce] = mint
+		}
+		return iterator.Error()
+	})
+	return mints, err
+}
+
+// BlockAtTimestamp returns the first block with a timestamp at or after the given one
+func BlockAtTimestamp(client *ethclient.Client, timestamp uint64) (uint64, error) {
+	latest, err := client.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		return 0, err
+	}
+	if latest.Time < timestamp {
+		return latest.Number.Uint64(), nil
+	}
+
+	low, high := uint64(0), latest.Number.Uint64()
+	for low < high {
+		middle := low + (high-low)/2
+		header, headerErr := client.HeaderByNumber(context.Background(), new(big.Int).SetUint64(middle))
+		if headerErr != nil {
+			return 0, headerErr
+		}
+		if header.Time < timestamp {
+			low = middle + 1
+		} else {
+			high = middle
+		}
+	}
+	return low, nil
+}
+
+// reconcileDestination sets the status of the transfers to the destination domain of the client,
+// from the usedNonces of its MessageTransmitter, and looks up their mint transactions. Mints are
+// only searched for after the earliest burn, as a message cannot be received before it is sent.
+func reconcileDestination(client *ethclient.Client, sourceDomain uint32, transfers []*Transfer, chunkSize uint64) error {
+	byTokenMessenger := make(map[common.Address][]*Transfer)
+	for _, transfer := range transfers {
+		byTokenMessenger[transfer.destinationTokenMessenger] = append(byTokenMessenger[transfer.destinationTokenMessenger], transfer)
+	}
+
+	toBlock, err := client.BlockNumber(context.Background())
+	if err != nil {
+		return err
+	}
+
+	for tokenMessengerAddress, tokenMessengerTransfers := range byTokenMessenger {
+		tokenMessenger, tokenMessengerErr := TokenMessenger.NewTokenMessenger(tokenMessengerAddress, client)
+		if tokenMessengerErr != nil {
+			return tokenMessengerErr
+		}
+		transmitterAddress, transmitterErr := tokenMessenger.LocalMessageTransmitter(&bind.CallOpts{})
+		if transmitterErr != nil {
+			return fmt.Errorf("failed to fetch MessageTransmitter of TokenMessenger %s: %v", tokenMessengerAddress.Hex(), transmitterErr)
+		}
+
+		var nonces []uint64
+		var earliest uint64
+		for _, transfer := range tokenMessengerTransfers {
+			message := &Message{SourceDomain: sourceDomain, Nonce: transfer.Nonce}
+			received, receivedErr := IsMessageReceived(client, transmitterAddress, message)
+			if receivedErr != nil {
+				return receivedErr
+			}
+
+			if received {
+				transfer.Status = TransferStatusMinted
+				nonces = append(nonces, transfer.Nonce)
+				if len(nonces) == 1 || transfer.burnTimestamp < earliest {
+					earliest = transfer.burnTimestamp
+				}
+			} else {
+				transfer.Status = TransferStatusPending
+			}
+		}
+
+		if len(nonces) == 0 {
+			continue
+		}
+
+		fromBlock, blockErr := BlockAtTimestamp(client, earliest)
+		if blockErr != nil {
+			return blockErr
+		}
+
+		mints, mintsErr := FetchMints(client, transmitterAddress, tokenMessengerAddress, sourceDomain, nonces, fromBlock, toBlock, chunkSize)
+		if mintsErr != nil {
+			return mintsErr
+		}
+		for _, transfer := range tokenMessengerTransfers {
+			if mint, ok := mints[transfer.Nonce]; ok {
+				transfer.MintTxHash = &mint.TxHash
+				transfer.MintedAmount = mint.Amount
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetTransferHistory returns the burns of the depositor on the source chain, reconciled with the
+// destination chains. Transfers to a domain without a destination client have the unknown status.
+func GetTransferHistory(sourceClient *ethclient.Client, destinationClients map[uint32]*ethclient.Client, tokenMessengerAddress common.Address, depositor common.Address, fromBlock uint64, toBlock uint64, chunkSize uint64) ([]*Transfer, error) {
+	tokenMessenger, err := TokenMessenger.NewTokenMessenger(tokenMessengerAddress, sourceClient)
+	if err != nil {
+		return nil, err
+	}
+	transmitterAddress, err := tokenMessenger.LocalMessageTransmitter(&bind.CallOpts{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch MessageTransmitter of TokenMessenger %s: %v", tokenMessengerAddress.Hex(), err)
+	}
+	transmitter, err := MessageTransmitter.NewMessageTransmitter(transmitterAddress, sourceClient)
+	if err != nil {
+		return nil, err
+	}
+	sourceDomain, err := transmitter.LocalDomain(&bind.CallOpts{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch local domain of MessageTransmitter: %v", err)
+	}
+
+	burns, err := FetchBurns(sourceClient, tokenMessengerAddress, depositor, fromBlock, toBlock, chunkSize)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Fprintln(os.Stderr, "Found", len(burns), "burn(s) by", depositor.Hex())
+
+	timestamps := make(map[uint64]uint64)
+	transfersByDestination := make(map[uint32][]*Transfer)
+	var transfers []*Transfer
+	for _, burn := range burns {
+		timestamp, ok := timestamps[burn.Raw.BlockNumber]
+		if !ok {
+			header, headerErr := sourceClient.HeaderByNumber(context.Background(), new(big.Int).SetUint64(burn.Raw.BlockNumber))
+			if headerErr != nil {
+				return nil, headerErr
+			}
+			timestamp = header.Time
+			timestamps[burn.Raw.BlockNumber] = timestamp
+		}
+
+		transfer := &Transfer{
+			SourceDomain:              sourceDomain,
+			DestinationDomain:         burn.DestinationDomain,
+			Nonce:                     burn.Nonce,
+			BurnTxHash:                burn.Raw.TxHash,
+			BurnBlock:                 burn.Raw.BlockNumber,
+			BurnToken:                 burn.BurnToken.Hex(),
+			Amount:                    burn.Amount,
+			MintRecipient:             FormatMintRecipient(burn.MintRecipient, ChainDomain(burn.DestinationDomain)),
+			Status:                    TransferStatusUnknown,
+			destinationTokenMessenger: common.BytesToAddress(burn.DestinationTokenMessenger[12:]),
+			burnTimestamp:             timestamp,
+		}
+		transfers = append(transfers, transfer)
+		transfersByDestination[burn.DestinationDomain] = append(transfersByDestination[burn.DestinationDomain], transfer)
+	}
+
+	for destinationDomain, destinationTransfers := range transfersByDestination {
+		destinationClient, ok := destinationClients[destinationDomain]
+		if !ok {
+			continue
+		}
+		if err := reconcileDestination(destinationClient, sourceDomain, destinationTransfers, chunkSize); err != nil {
+			return nil, fmt.Errorf("failed to reconcile transfers to %s: %v", ChainDomain(destinationDomain).String(), err)
+		}
+	}
+
+	return transfers, nil
+}
+
+func printTransfers(transfers []*Transfer) {
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "NONCE\tDESTINATION\tAMOUNT\tRECIPIENT\tBURN TX\tSTATUS\tMINT TX")
+	for _, transfer := range transfers {
+		mintTxHash := "-"
+		if transfer.MintTxHash != nil {
+			mintTxHash = transfer.MintTxHash.Hex()
+		}
+		fmt.Fprintf(writer, "%d\t%s\t%s\t%s\t%s\t%s\t%s\n", transfer.Nonce, ChainDomain(transfer.DestinationDomain).String(), transfer.Amount.String(), transfer.MintRecipient, transfer.BurnTxHash.Hex(), transfer.Status, mintTxHash)
+	}
+	writer.Flush()
+}
+
+func CreateHistoryCommand() *cobra.Command {
+	var rpc, addressRaw, contractRaw string
+	var destinationRpcs []string
+	var fromBlock, toBlock, chunkSize uint64
+	var jsonOutput bool
+	var address, contract common.Address
+
+	historyCmd := &cobra.Command{
+		Use:   "history",
+		Short: "List the CCTP transfers of an address and whether they were minted",
+		Long: `List the CCTP transfers of an address and whether they were minted
+
+Scans the source chain for the DepositForBurn events of the depositor and every destination RPC for
+the mint of each burn, by nonce. Transfers to a domain without a --destination-rpc have the unknown
+status.`,
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if rpc == "" {
+				return errors.New("rpc is required")
+			}
+
+			if !common.IsHexAddress(addressRaw) {
+				return errors.New("invalid address")
+			}
+			address = common.HexToAddress(addressRaw)
+
+			if chunkSize == 0 {
+				return errors.New("chunk size must be positive")
+			}
+
+			if toBlock != 0 && toBlock < fromBlock {
+				return errors.New("--to-block must not be before --from-block")
+			}
+
+			if contractRaw == "" {
+				sourceDomain, sourceContracts, sourceErr := GetRpcDomain(rpc)
+				if sourceErr != nil {
+					return fmt.Errorf("%v, --contract is required", sourceErr)
+				}
+				contractRaw = sourceContracts.TokenMessenger.Hex()
+				fmt.Fprintln(os.Stderr, "--contract not specified, using TokenMessenger on", sourceDomain.Name, "(", contractRaw, ")")
+			}
+			if !common.IsHexAddress(contractRaw) {
+				return errors.New("invalid contract address")
+			}
+			contract = common.HexToAddress(contractRaw)
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sourceClient, err := ethclient.Dial(rpc)
+			if err != nil {
+				return err
+			}
+
+			if toBlock == 0 {
+				toBlock, err = sourceClient.BlockNumber(context.Background())
+				if err != nil {
+					return err
+				}
+			}
+
+			destinationClients := make(map[uint32]*ethclient.Client)
+			for _, destinationRpc := range destinationRpcs {
+				destinationClient, dialErr := ethclient.Dial(destinationRpc)
+				if dialErr != nil {
+					return dialErr
+				}
+				chainID, chainIDErr := destinationClient.ChainID(context.Background())
+				if chainIDErr != nil {
+					return chainIDErr
+				}
+				destinationDomain, _, domainErr := DomainByChainID(chainID.Uint64())
+				if domainErr != nil {
+					return domainErr
+				}
+				destinationClients[uint32(destinationDomain.ID)] = destinationClient
+			}
+
+			transfers, err := GetTransferHistory(sourceClient, destinationClients, contract, address, fromBlock, toBlock, chunkSize)
+			if err != nil {
+				return err
+			}
+
+			if jsonOutput {
+				encoder := json.NewEncoder(os.Stdout)
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(transfers)
+			}
+
+			printTransfers(transfers)
+			return nil
+		},
+	}
+
+	historyCmd.Flags().StringVar(&rpc, "rpc", "", "RPC URL of the source domain")
+	historyCmd.Flags().StringArrayVar(&destinationRpcs, "destination-rpc", nil, "RPC URL of a destination domain, repeated once per destination")
+	historyCmd.Flags().StringVar(&addressRaw, "address", "", "Depositor to list the transfers of")
+	historyCmd.Flags().StringVar(&contractRaw, "contract", "", "TokenMessenger the tokens were burned with (optional, defaults to the TokenMessenger on the source chain)")
+	historyCmd.Flags().Uint64Var(&fromBlock, "from-block", 0, "First block to scan on the source domain")
+	historyCmd.Flags().Uint64Var(&toBlock, "to-block", 0, "Last block to scan on the source domain (optional, defaults to the latest block)")
+	historyCmd.Flags().Uint64Var(&chunkSize, "chunk-size", DefaultHistoryChunkSize, "Maximum number of blocks per log query")
+	historyCmd.Flags().BoolVar(&jsonOutput, "json", false, "Output the transfers as JSON instead of a table")
+
+	return historyCmd
+}
@@ -0,0 +1,42 @@
+package cctp
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestScanBlocks(t *testing.T) {
+	var ranges [][2]uint64
+	err := scanBlocks(10, 24, 5, func(start uint64, end uint64) error {
+		ranges = append(ranges, [2]uint64{start, end})
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := [][2]uint64{{10, 14}, {15, 19}, {20, 24}}
+	if len(ranges) != len(expected) {
+		t.Fatalf("expected %d ranges, got %v", len(expected), ranges)
+	}
+	for i := range expected {
+		if ranges[i] != expected[i] {
+			t.Errorf("range %d: expected %v, got %v", i, expected[i], ranges[i])
+		}
+	}
+
+	ranges = nil
+	scanBlocks(7, 7, 5, func(start uint64, end uint64) error {
+		ranges = append(ranges, [2]uint64{start, end})
+		return nil
+	})
+	if len(ranges) != 1 || ranges[0] != [2]uint64{7, 7} {
+		t.Errorf("expected a single range 7-7, got %v", ranges)
+	}
+
+	if err := scanBlocks(0, 100, 10, func(start uint64, end uint64) error {
+		return errors.New("rate limited")
+	}); err == nil {
+		t.Error("expected the error of the scan")
+	}
+}
@@ -0,0 +1,126 @@
+package cctp
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// EncodeHookData builds the hook data of depositForBurnWithHook as calldata: the selector of the
+// function signature, e.g. "deposit(address,uint256)", followed by the ABI encoded arguments.
+// Tuples are not supported.
+func EncodeHookData(signature string, args []string) ([]byte, error) {
+	signature = strings.ReplaceAll(signature, " ", "")
+	open := strings.Index(signature, "(")
+	if open < 1 || !strings.HasSuffix(signature, ")") {
+		return nil, fmt.Errorf("invalid function signature %s, expected e.g. deposit(address,uint256)", signature)
+	}
+
+	var typeNames []string
+	if inner := signature[open+1 : len(signature)-1]; inner != "" {
+		typeNames = strings.Split(inner, ",")
+	}
+	if len(typeNames) != len(args) {
+		return nil, fmt.Errorf("%s takes %d arguments, got %d", signature, len(typeNames), len(args))
+	}
+
+	arguments := make(abi.Arguments, len(typeNames))
+	values := make([]interface{}, len(typeNames))
+	for i, typeName := range typeNames {
+		argumentType, err := abi.NewType(typeName, "", nil)
+		if err != nil {
+			return nil, fmt.Errorf("invalid type %s: %v", typeName, err)
+		}
+		arguments[i] = abi.Argument{Type: argumentType}
+
+		value, err := parseHookArgument(argumentType, args[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid argument %d (%s): %v", i, typeName, err)
+		}
+		values[i] = value
+	}
+
+	encoded, err := arguments.Pack(values...)
+	if err != nil {
+		return nil, err
+	}
+
+	selector := crypto.Keccak256([]byte(signature))[:4]
+	return append(selector, encoded...), nil
+}
+
+// parseHookArgument converts a command line argument to the Go value the ABI encoder expects for
+// the type
+func parseHookArgument(argumentType abi.Type, raw string) (interface{}, error) {
+	switch argumentType.T {
+	case abi.AddressTy:
+		if !common.IsHexAddress(raw) {
+			return nil, fmt.Errorf("invalid address %s", raw)
+		}
+		return common.HexToAddress(raw), nil
+
+	case abi.BoolTy:
+		return strconv.ParseBool(raw)
+
+	case abi.StringTy:
+		return raw, nil
+
+	case abi.BytesTy:
+		if !strings.HasPrefix(raw, "0x") {
+			return nil, fmt.Errorf("expected 0x prefixed hex, got %s", raw)
+		}
+		return common.FromHex(raw), nil
+
+	case abi.FixedBytesTy:
+		decoded := common.FromHex(raw)
+		if !strings.HasPrefix(raw, "0x") || len(decoded) != argumentType.Size {
+			return nil, fmt.Errorf("expected 0x prefixed hex of %d bytes, got %s", argumentType.Size, raw)
+		}
+		array := reflect.New(argumentType.GetType()).Elem()
+		reflect.Copy(array, reflect.ValueOf(decoded))
+		return array.Interface(), nil
+
+	case abi.UintTy, abi.IntTy:
+		value, ok := new(big.Int).SetString(raw, 0)
+		if !ok {
+			return nil, fmt.Errorf("invalid integer %s", raw)
+		}
+		if argumentType.T == abi.UintTy && value.Sign() < 0 {
+			return nil, fmt.Errorf("negative value %s for an unsigned integer", raw)
+		}
+		bits := argumentType.Size
+		magnitude := value
+		if argumentType.T == abi.IntTy {
+			// One bit is the sign, and the range of negative values goes one further than the positive one
+			bits--
+			if value.Sign() < 0 {
+				magnitude = new(big.Int).Add(value, big.NewInt(1))
+			}
+		}
+		if magnitude.BitLen() > bits {
+			return nil, fmt.Errorf("%s overflows %d bits", raw, argumentType.Size)
+		}
+		return convertInteger(argumentType, value), nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", argumentType.String())
+}
+
+// convertInteger returns value as the Go type the ABI encoder expects for the integer type: a native
+// integer for 8, 16, 32 and 64 bits and *big.Int otherwise
+func convertInteger(argumentType abi.Type, value *big.Int) interface{} {
+	goType := argumentType.GetType()
+	switch goType.Kind() {
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return reflect.ValueOf(value.Uint64()).Convert(goType).Interface()
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflect.ValueOf(value.Int64()).Convert(goType).Interface()
+	}
+	return value
+}
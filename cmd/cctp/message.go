@@ -0,0 +1,152 @@
+package cctp
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/G7DAO/bifrost/bindings/MessageTransmitter"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// messageHeaderLength is the length of the fixed fields of a CCTP message, before the message body
+// Source: https://github.com/circlefin/evm-cctp-contracts/blob/master/src/messages/Message.sol
+const messageHeaderLength = 116
+
+// MessageVersion is the version of the messages of MessageTransmitter. MessageTransmitterV2 emits
+// version 1 messages under the same MessageSent event.
+const MessageVersion = 0
+
+// Message is a CCTP message as emitted by MessageTransmitter.MessageSent
+type Message struct {
+	Version           uint32
+	SourceDomain      uint32
+	DestinationDomain uint32
+	Nonce             uint64
+	Sender            [32]byte
+	Recipient         [32]byte
+	DestinationCaller [32]byte
+	Body              []byte
+	Raw               []byte
+}
+
+// ParseMessage decodes the fixed fields of a CCTP message. It rejects messages of CCTP V2, whose
+// header is laid out differently.
+func ParseMessage(raw []byte) (*Message, error) {
+	if len(raw) < 4 {
+		return nil, fmt.Errorf("invalid message length: expected at least %d bytes, got %d", messageHeaderLength, len(raw))
+	}
+	if version := binary.BigEndian.Uint32(raw[0:4]); version != MessageVersion {
+		return nil, fmt.Errorf("unsupported message version %d, CCTP V2 messages must be received with --v2", version)
+	}
+	if len(raw) < messageHeaderLength {
+		return nil, fmt.Errorf("invalid message length: expected at least %d bytes, got %d", messageHeaderLength, len(raw))
+	}
+
+	message := &Message{
+		Version:           binary.BigEndian.Uint32(raw[0:4]),
+		SourceDomain:      binary.BigEndian.Uint32(raw[4:8]),
+		DestinationDomain: binary.BigEndian.Uint32(raw[8:12]),
+		Nonce:             binary.BigEndian.Uint64(raw[12:20]),
+		Body:              raw[messageHeaderLength:],
+		Raw:               raw,
+	}
+	copy(message.Sender[:], raw[20:52])
+	copy(message.Recipient[:], raw[52:84])
+	copy(message.DestinationCaller[:], raw[84:116])
+
+	return message, nil
+}
+
+// Hash returns the keccak256 hash of the message, which is how Circle's attestation service
+// identifies it
+func (m *Message) Hash() common.Hash {
+	return crypto.Keccak256Hash(m.Raw)
+}
+
+// NonceHash returns the key under which MessageTransmitter.usedNonces records the message as received
+func (m *Message) NonceHash() [32]byte {
+	var packed [12]byte
+	binary.BigEndian.PutUint32(packed[0:4], m.SourceDomain)
+	binary.BigEndian.PutUint64(packed[4:12], m.Nonce)
+	return crypto.Keccak256Hash(packed[:])
+}
+
+// GetMessagesFromReceipt returns the messages emitted by MessageTransmitter.MessageSent in the receipt,
+// in log order. Only the logs of the transmitter are read, as MessageTransmitterV2 emits V2 messages
+// under the same event.
+func GetMessagesFromReceipt(receipt *types.Receipt, transmitter common.Address) ([]*Message, error) {
+	transmitterAbi, err := MessageTransmitter.MessageTransmitterMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	messageSentEvent := transmitterAbi.Events["MessageSent"]
+
+	var messages []*Message
+	otherTransmitters := 0
+	for _, log := range receipt.Logs {
+		if len(log.Topics) == 0 || log.Topics[0] != messageSentEvent.ID {
+			continue
+		}
+		if log.Address != transmitter {
+			otherTransmitters++
+			continue
+		}
+
+		values, unpackErr := messageSentEvent.Inputs.Unpack(log.Data)
+		if unpackErr != nil {
+			return nil, fmt.Errorf("failed to unpack MessageSent log %d: %v", log.Index, unpackErr)
+		}
+		raw, ok := values[0].([]byte)
+		if !ok {
+			return nil, fmt.Errorf("unexpected MessageSent log %d", log.Index)
+		}
+
+		message, parseErr := ParseMessage(raw)
+		if parseErr != nil {
+			return nil, parseErr
+		}
+		messages = append(messages, message)
+	}
+
+	if len(messages) == 0 && otherTransmitters > 0 {
+		return nil, fmt.Errorf("no MessageSent event of MessageTransmitter %s in the transaction, but %d of other contracts: use --v2 if the transfer was burned with CCTP V2", transmitter.Hex(), otherTransmitters)
+	}
+	if len(messages) == 0 {
+		return nil, errors.New("no MessageSent event found in the transaction")
+	}
+	return messages, nil
+}
+
+// burnMessageLength is the length of the body of a depositForBurn message
+// Source: https://github.com/circlefin/evm-cctp-contracts/blob/master/src/messages/BurnMessage.sol
+const burnMessageLength = 132
+
+// BurnMessage is the body of a message sent by TokenMessenger.depositForBurn
+type BurnMessage struct {
+	Version       uint32
+	BurnToken     [32]byte
+	MintRecipient [32]byte
+	Amount        *big.Int
+	MessageSender [32]byte
+}
+
+// ParseBurnMessage decodes the body of a depositForBurn message
+func ParseBurnMessage(body []byte) (*BurnMessage, error) {
+	if len(body) != burnMessageLength {
+		return nil, fmt.Errorf("invalid burn message length: expected %d bytes, got %d", burnMessageLength, len(body))
+	}
+
+	burnMessage := &BurnMessage{
+		Version: binary.BigEndian.Uint32(body[0:4]),
+		Amount:  new(big.Int).SetBytes(body[68:100]),
+	}
+	copy(burnMessage.BurnToken[:], body[4:36])
+	copy(burnMessage.MintRecipient[:], body[36:68])
+	copy(burnMessage.MessageSender[:], body[100:132])
+
+	return burnMessage, nil
+}
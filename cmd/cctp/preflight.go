@@ -0,0 +1,107 @@
+package cctp
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/G7DAO/bifrost/bindings/ERC20"
+	"github.com/G7DAO/bifrost/bindings/TokenMessenger"
+	"github.com/G7DAO/bifrost/bindings/TokenMinter"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// BurnPreflight is the state depositForBurn depends on: the balance and TokenMessenger allowance of
+// the sender, and the maximum amount the TokenMinter burns per message
+type BurnPreflight struct {
+	Balance   *big.Int
+	Allowance *big.Int
+	BurnLimit *big.Int
+}
+
+// GetBurnPreflight reads the balance and allowance of the sender and the burn limit of the token
+func GetBurnPreflight(client *ethclient.Client, contractAddress common.Address, token common.Address, sender common.Address) (*BurnPreflight, error) {
+	tokenMessenger, err := TokenMessenger.NewTokenMessenger(contractAddress, client)
+	if err != nil {
+		return nil, err
+	}
+
+	minterAddress, err := tokenMessenger.LocalMinter(&bind.CallOpts{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch local minter of TokenMessenger %s: %v", contractAddress.Hex(), err)
+	}
+
+	minter, err := TokenMinter.NewTokenMinter(minterAddress, client)
+	if err != nil {
+		return nil, err
+	}
+
+	burnLimit, err := minter.BurnLimitsPerMessage(&bind.CallOpts{}, token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch burn limit of %s: %v", token.Hex(), err)
+	}
+
+	erc20, err := ERC20.NewERC20(token, client)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := erc20.BalanceOf(&bind.CallOpts{}, sender)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch balance of %s: %v", sender.Hex(), err)
+	}
+
+	allowance, err := erc20.Allowance(&bind.CallOpts{}, sender, contractAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch allowance of %s: %v", sender.Hex(), err)
+	}
+
+	return &BurnPreflight{Balance: balance, Allowance: allowance, BurnLimit: burnLimit}, nil
+}
+
+// CheckBalance returns an error if the balance of the sender does not cover the amount
+func (p *BurnPreflight) CheckBalance(amount *big.Int) error {
+	if p.Balance.Cmp(amount) < 0 {
+		return fmt.Errorf("insufficient balance: %s, need %s", p.Balance.String(), amount.String())
+	}
+	return nil
+}
+
+// GetBurnAmounts returns the amounts to burn, one per depositForBurn. Amounts above the burn limit
+// are split into messages of at most the limit if split is set, and rejected otherwise.
+func (p *BurnPreflight) GetBurnAmounts(amount *big.Int, split bool) ([]*big.Int, error) {
+	if amount.Sign() <= 0 {
+		return nil, errors.New("amount must be positive")
+	}
+
+	if p.BurnLimit.Sign() == 0 {
+		return nil, errors.New("token is not supported by the TokenMinter, its burn limit per message is 0")
+	}
+
+	if amount.Cmp(p.BurnLimit) <= 0 {
+		return []*big.Int{amount}, nil
+	}
+
+	if !split {
+		return nil, fmt.Errorf("amount %s exceeds the burn limit per message of %s, use --split to send it as several messages", amount.String(), p.BurnLimit.String())
+	}
+
+	var amounts []*big.Int
+	remaining := new(big.Int).Set(amount)
+	for remaining.Sign() > 0 {
+		chunk := new(big.Int).Set(p.BurnLimit)
+		if remaining.Cmp(chunk) < 0 {
+			chunk.Set(remaining)
+		}
+		amounts = append(amounts, chunk)
+		remaining.Sub(remaining, chunk)
+	}
+	return amounts, nil
+}
+
+// NeedsApproval returns true if the allowance of the TokenMessenger does not cover the amount
+func (p *BurnPreflight) NeedsApproval(amount *big.Int) bool {
+	return p.Allowance.Cmp(amount) < 0
+}
@@ -0,0 +1,281 @@
+package cctp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"time"
+
+	"github.com/G7DAO/bifrost/bindings/MessageTransmitter"
+	"github.com/G7DAO/bifrost/bindings/TokenMessenger"
+	"github.com/G7DAO/bifrost/cmd/transaction"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/cobra"
+)
+
+var sourceTxHashRegex = regexp.MustCompile("^0x[0-9a-fA-F]{64}$")
+
+func GetReceiveMessageCalldata(message []byte, attestation []byte) ([]byte, error) {
+	abi, err := MessageTransmitter.MessageTransmitterMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.Pack("receiveMessage", message, attestation)
+}
+
+// SourceMessageTransmitter returns the MessageTransmitter of the source domain: the one of the
+// TokenMessenger if it is set, or the known MessageTransmitter of the source chain otherwise
+func SourceMessageTransmitter(sourceClient *ethclient.Client, sourceChainID uint64, tokenMessengerAddress *common.Address) (common.Address, error) {
+	if tokenMessengerAddress == nil {
+		_, sourceContracts, err := DomainByChainID(sourceChainID)
+		if err != nil {
+			return common.Address{}, err
+		}
+		return sourceContracts.MessageTransmitter, nil
+	}
+
+	tokenMessenger, err := TokenMessenger.NewTokenMessenger(*tokenMessengerAddress, sourceClient)
+	if err != nil {
+		return common.Address{}, err
+	}
+	transmitterAddress, err := tokenMessenger.LocalMessageTransmitter(&bind.CallOpts{})
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to fetch MessageTransmitter of TokenMessenger %s: %v", tokenMessengerAddress.Hex(), err)
+	}
+	return transmitterAddress, nil
+}
+
+// GetSourceMessage returns the CCTP message at messageIndex among those emitted by the source
+// MessageTransmitter in the source transaction
+func GetSourceMessage(sourceClient *ethclient.Client, sourceTransmitter common.Address, sourceTxHash common.Hash, messageIndex int) (*Message, error) {
+	receipt, err := sourceClient.TransactionReceipt(context.Background(), sourceTxHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch receipt of %s: %v", sourceTxHash.Hex(), err)
+	}
+
+	messages, err := GetMessagesFromReceipt(receipt, sourceTransmitter)
+	if err != nil {
+		return nil, err
+	}
+	if messageIndex < 0 || messageIndex >= len(messages) {
+		return nil, fmt.Errorf("invalid message index %d, the transaction emitted %d message(s)", messageIndex, len(messages))
+	}
+
+	return messages[messageIndex], nil
+}
+
+// IsMessageReceived returns true if the message was already received by the MessageTransmitter
+func IsMessageReceived(client *ethclient.Client, transmitterAddress common.Address, message *Message) (bool, error) {
+	transmitter, err := MessageTransmitter.NewMessageTransmitter(transmitterAddress, client)
+	if err != nil {
+		return false, err
+	}
+
+	used, err := transmitter.UsedNonces(&bind.CallOpts{}, message.NonceHash())
+	if err != nil {
+		return false, fmt.Errorf("failed to check if nonce %d was used: %v", message.Nonce, err)
+	}
+	return used.Sign() != 0, nil
+}
+
+func cctpReceive(key *keystore.Key, destinationClient *ethclient.Client, message *Message, transmitterAddress common.Address, attestationApi string, pollInterval time.Duration, timeout time.Duration, txFlags *transaction.Flags) error {
+	fmt.Println("Message hash:", message.Hash().Hex())
+	fmt.Println("Source domain:", ChainDomain(message.SourceDomain).String(), "Destination domain:", ChainDomain(message.DestinationDomain).String(), "Nonce:", message.Nonce)
+
+	transmitter, err := MessageTransmitter.NewMessageTransmitter(transmitterAddress, destinationClient)
+	if err != nil {
+		return err
+	}
+
+	localDomain, err := transmitter.LocalDomain(&bind.CallOpts{})
+	if err != nil {
+		return fmt.Errorf("failed to fetch local domain of MessageTransmitter: %v", err)
+	}
+	if localDomain != message.DestinationDomain {
+		return fmt.Errorf("message is for domain %d, but the MessageTransmitter on the destination RPC is on domain %d", message.DestinationDomain, localDomain)
+	}
+
+	received, err := IsMessageReceived(destinationClient, transmitterAddress, message)
+	if err != nil {
+		return err
+	}
+	if received {
+		fmt.Println("Message was already received on the destination domain")
+		return nil
+	}
+
+	if message.DestinationCaller != ([32]byte{}) {
+		caller, _ := ParseMintRecipientFrom20BytesTo32Bytes(key.Address)
+		if caller != message.DestinationCaller {
+			return fmt.Errorf("message can only be received by destination caller 0x%x", message.DestinationCaller)
+		}
+	}
+
+	attestation, err := WaitForAttestation(context.Background(), attestationApi, message.Hash(), pollInterval, timeout)
+	if err != nil {
+		return err
+	}
+
+	receiveMessageData, err := GetReceiveMessageCalldata(message.Raw, attestation.Bytes())
+	if err != nil {
+		return err
+	}
+
+	tx, err := transaction.Send(context.Background(), destinationClient, key, transmitterAddress, big.NewInt(0), receiveMessageData, txFlags)
+	if err != nil {
+		return err
+	}
+
+	if !txFlags.Unsigned() {
+		fmt.Println("Transaction hash:", tx.Hash().Hex())
+	}
+	return nil
+}
+
+func CreateReceiveCommand() *cobra.Command {
+	var keyFile, password, rpc, destinationRpc, transmitterRaw, sourceContractRaw, attestationApi string
+	var messageIndex int
+	var v2 bool
+	var pollInterval, timeout time.Duration
+	var sourceTxHash common.Hash
+	var transmitter common.Address
+	var sourceContract *common.Address
+	txFlags := &transaction.Flags{}
+
+	receiveCmd := &cobra.Command{
+		Use:   "receive <source-tx-hash>",
+		Short: "Mint the tokens burned by a CCTP transfer on the destination domain",
+		Long: `Mint the tokens burned by a CCTP transfer on the destination domain
+
+Extracts the MessageSent message from the source transaction, waits for its attestation and calls
+MessageTransmitter.receiveMessage on the destination RPC.
+
+With --v2, fetches the attested message from the V2 messages API instead, as V2 nonces are only
+assigned by the attestation service, and calls MessageTransmitterV2.receiveMessage.`,
+		Args: cobra.ExactArgs(1),
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if !sourceTxHashRegex.MatchString(args[0]) {
+				return errors.New("invalid source transaction hash")
+			}
+			sourceTxHash = common.HexToHash(args[0])
+
+			if keyFile == "" && !txFlags.Unsigned() {
+				return errors.New("keyfile is required")
+			}
+
+			if rpc == "" {
+				return errors.New("rpc is required")
+			}
+
+			if destinationRpc == "" {
+				return errors.New("destination rpc is required")
+			}
+
+			if transmitterRaw == "" {
+				destinationDomain, destinationContracts, destinationErr := GetRpcDomain(destinationRpc)
+				if destinationErr != nil {
+					return fmt.Errorf("%v, --message-transmitter is required", destinationErr)
+				}
+				if v2 {
+					if !destinationDomain.V2 {
+						return fmt.Errorf("%s does not support CCTP V2", destinationDomain.Name)
+					}
+					_, transmitterV2 := V2Contracts(IsTestnet(destinationContracts.ChainID))
+					transmitterRaw = transmitterV2.Hex()
+					fmt.Println("--message-transmitter not specified, using MessageTransmitterV2 on", destinationDomain.Name, "(", transmitterRaw, ")")
+				} else {
+					transmitterRaw = destinationContracts.MessageTransmitter.Hex()
+					fmt.Println("--message-transmitter not specified, using MessageTransmitter on", destinationDomain.Name, "(", transmitterRaw, ")")
+				}
+			}
+			if !common.IsHexAddress(transmitterRaw) {
+				return errors.New("invalid MessageTransmitter address")
+			}
+			transmitter = common.HexToAddress(transmitterRaw)
+
+			if sourceContractRaw != "" {
+				if !common.IsHexAddress(sourceContractRaw) {
+					return errors.New("invalid source contract address")
+				}
+				sourceContractAddress := common.HexToAddress(sourceContractRaw)
+				sourceContract = &sourceContractAddress
+			}
+
+			if pollInterval <= 0 {
+				return errors.New("poll interval must be positive")
+			}
+
+			if txErr := txFlags.Parse(); txErr != nil {
+				return txErr
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := txFlags.Key(keyFile, password)
+			if err != nil {
+				return err
+			}
+
+			sourceClient, err := ethclient.Dial(rpc)
+			if err != nil {
+				return err
+			}
+
+			destinationClient, err := ethclient.Dial(destinationRpc)
+			if err != nil {
+				return err
+			}
+
+			sourceChainID, err := sourceClient.ChainID(context.Background())
+			if err != nil {
+				return err
+			}
+			if attestationApi == "" {
+				attestationApi = DefaultAttestationApi(sourceChainID.Uint64())
+			}
+
+			if v2 {
+				sourceDomain, _, sourceErr := DomainByChainID(sourceChainID.Uint64())
+				if sourceErr != nil {
+					return sourceErr
+				}
+				return cctpReceiveV2(key, destinationClient, uint32(sourceDomain.ID), sourceTxHash, messageIndex, transmitter, attestationApi, pollInterval, timeout, txFlags)
+			}
+
+			sourceTransmitter, err := SourceMessageTransmitter(sourceClient, sourceChainID.Uint64(), sourceContract)
+			if err != nil {
+				return fmt.Errorf("%v, --source-contract is required", err)
+			}
+
+			message, err := GetSourceMessage(sourceClient, sourceTransmitter, sourceTxHash, messageIndex)
+			if err != nil {
+				return err
+			}
+
+			return cctpReceive(key, destinationClient, message, transmitter, attestationApi, pollInterval, timeout, txFlags)
+		},
+	}
+
+	receiveCmd.Flags().StringVar(&password, "password", "", "Password to decrypt the keyfile with")
+	receiveCmd.Flags().StringVar(&keyFile, "keyfile", "", "Keyfile to sign the receiveMessage transaction with")
+	receiveCmd.Flags().StringVar(&rpc, "rpc", "", "RPC URL of the source domain")
+	receiveCmd.Flags().StringVar(&destinationRpc, "destination-rpc", "", "RPC URL of the destination domain")
+	receiveCmd.Flags().StringVar(&transmitterRaw, "message-transmitter", "", "MessageTransmitter contract on the destination domain (optional, defaults to the known MessageTransmitter of the destination chain)")
+	receiveCmd.Flags().StringVar(&sourceContractRaw, "source-contract", "", "TokenMessenger contract on the source domain, whose MessageTransmitter emitted the message (optional, defaults to the known TokenMessenger of the source chain)")
+	receiveCmd.Flags().BoolVar(&v2, "v2", false, "Receive a message burned with CCTP V2")
+	receiveCmd.Flags().StringVar(&attestationApi, "attestation-api", "", "Attestation service URL (optional, defaults to Circle's attestation service for the source network)")
+	receiveCmd.Flags().IntVar(&messageIndex, "message-index", 0, "Index of the message to receive, if the source transaction emitted several")
+	receiveCmd.Flags().DurationVar(&pollInterval, "poll-interval", DefaultAttestationPollInterval, "Interval between attestation requests")
+	receiveCmd.Flags().DurationVar(&timeout, "timeout", DefaultAttestationTimeout, "Maximum time to wait for the attestation")
+	txFlags.AddFlags(receiveCmd)
+
+	return receiveCmd
+}
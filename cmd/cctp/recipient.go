@@ -0,0 +1,210 @@
+package cctp
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const NobleAddressPrefix = "noble"
+
+var bytes32HexRegex = regexp.MustCompile("^0x[0-9a-fA-F]{64}$")
+
+// ParseMintRecipient converts a recipient of the destination domain to the bytes32 mintRecipient of
+// depositForBurn:
+//   - EVM domains take a 20 bytes hex address, left-padded to 32 bytes
+//   - Solana takes a base58 wallet address, and USDC is minted to its associated token account unless
+//     tokenAccount is set, in which case the address is used as is
+//   - Noble takes a bech32 noble1... address, left-padded to 32 bytes
+//   - Sui and Aptos take a 32 bytes hex address
+func ParseMintRecipient(recipient string, domain ChainDomain, testnet bool, tokenAccount bool) ([32]byte, error) {
+	var mintRecipient [32]byte
+
+	if recipient == "" {
+		return mintRecipient, errors.New("recipient is required")
+	}
+
+	switch domain {
+	case ChainDomainSolana:
+		owner, err := ParseSolanaAddress(recipient)
+		if err != nil {
+			return mintRecipient, err
+		}
+		if tokenAccount {
+			return owner, nil
+		}
+
+		contracts := DomainByID(ChainDomainSolana).Contracts(testnet)
+		if contracts == nil || contracts.NativeUSDC == "" {
+			return mintRecipient, errors.New("the USDC mint of Solana is unknown")
+		}
+		mint, err := ParseSolanaAddress(contracts.NativeUSDC)
+		if err != nil {
+			return mintRecipient, err
+		}
+		return GetAssociatedTokenAddress(owner, mint)
+
+	case ChainDomainNoble:
+		hrp, decoded, err := DecodeBech32(recipient)
+		if err != nil {
+			return mintRecipient, fmt.Errorf("invalid Noble address %s: %v", recipient, err)
+		}
+		if hrp != NobleAddressPrefix {
+			return mintRecipient, fmt.Errorf("invalid Noble address %s: expected prefix %s, got %s", recipient, NobleAddressPrefix, hrp)
+		}
+		if len(decoded) != 20 && len(decoded) != 32 {
+			return mintRecipient, fmt.Errorf("invalid Noble address %s: expected 20 or 32 bytes, got %d", recipient, len(decoded))
+		}
+		copy(mintRecipient[32-len(decoded):], decoded)
+		return mintRecipient, nil
+
+	case ChainDomainSui, ChainDomainAptos:
+		if !bytes32HexRegex.MatchString(recipient) {
+			return mintRecipient, fmt.Errorf("invalid %s address %s: expected 0x followed by 64 hex characters", domain.String(), recipient)
+		}
+		copy(mintRecipient[:], common.FromHex(recipient))
+		return mintRecipient, nil
+	}
+
+	if !common.IsHexAddress(recipient) {
+		return mintRecipient, errors.New("invalid recipient address")
+	}
+	return ParseMintRecipientFrom20BytesTo32Bytes(common.HexToAddress(recipient))
+}
+
+// ParseDestinationAddress converts an address of the destination domain to bytes32, like
+// ParseMintRecipient but without deriving Solana token accounts
+func ParseDestinationAddress(address string, domain ChainDomain) ([32]byte, error) {
+	return ParseMintRecipient(address, domain, false, true)
+}
+
+// FormatMintRecipient renders a mintRecipient in the address format of the destination domain
+func FormatMintRecipient(mintRecipient [32]byte, domain ChainDomain) string {
+	switch domain {
+	case ChainDomainSolana:
+		return EncodeBase58(mintRecipient[:])
+	case ChainDomainSui, ChainDomainAptos:
+		return "0x" + common.Bytes2Hex(mintRecipient[:])
+	case ChainDomainNoble:
+		if encoded, err := EncodeBech32(NobleAddressPrefix, mintRecipient[12:]); err == nil {
+			return encoded
+		}
+	}
+	return common.BytesToAddress(mintRecipient[12:]).Hex()
+}
+
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// Source: https://github.com/bitcoin/bips/blob/master/bip-0173.mediawiki
+func bech32Polymod(values []byte) uint32 {
+	generator := []uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	checksum := uint32(1)
+	for _, value := range values {
+		top := checksum >> 25
+		checksum = (checksum&0x1ffffff)<<5 ^ uint32(value)
+		for i := 0; i < 5; i++ {
+			if (top>>i)&1 == 1 {
+				checksum ^= generator[i]
+			}
+		}
+	}
+	return checksum
+}
+
+func bech32HrpExpand(hrp string) []byte {
+	expanded := make([]byte, 0, len(hrp)*2+1)
+	for _, c := range hrp {
+		expanded = append(expanded, byte(c)>>5)
+	}
+	expanded = append(expanded, 0)
+	for _, c := range hrp {
+		expanded = append(expanded, byte(c)&31)
+	}
+	return expanded
+}
+
+// convertBits regroups a byte slice from fromBits to toBits bits per byte
+func convertBits(data []byte, fromBits uint, toBits uint, pad bool) ([]byte, error) {
+	var result []byte
+	accumulator := uint32(0)
+	bits := uint(0)
+	maxValue := uint32(1<<toBits) - 1
+	for _, value := range data {
+		if uint32(value)>>fromBits != 0 {
+			return nil, fmt.Errorf("invalid data byte %d", value)
+		}
+		accumulator = accumulator<<fromBits | uint32(value)
+		bits += fromBits
+		for bits >= toBits {
+			bits -= toBits
+			result = append(result, byte(accumulator>>bits&maxValue))
+		}
+	}
+
+	if pad {
+		if bits > 0 {
+			result = append(result, byte(accumulator<<(toBits-bits)&maxValue))
+		}
+	} else if bits >= fromBits || accumulator<<(toBits-bits)&maxValue != 0 {
+		return nil, errors.New("invalid padding")
+	}
+	return result, nil
+}
+
+// DecodeBech32 decodes a bech32 address, as used by Cosmos chains like Noble, into its human readable
+// prefix and data bytes
+func DecodeBech32(address string) (string, []byte, error) {
+	if strings.ToLower(address) != address && strings.ToUpper(address) != address {
+		return "", nil, errors.New("mixed case")
+	}
+	address = strings.ToLower(address)
+
+	separator := strings.LastIndexByte(address, '1')
+	if separator < 1 || separator+7 > len(address) {
+		return "", nil, errors.New("invalid separator position")
+	}
+
+	hrp := address[:separator]
+	var data []byte
+	for _, c := range address[separator+1:] {
+		value := strings.IndexRune(bech32Charset, c)
+		if value < 0 {
+			return "", nil, fmt.Errorf("invalid character %q", c)
+		}
+		data = append(data, byte(value))
+	}
+
+	if bech32Polymod(append(bech32HrpExpand(hrp), data...)) != 1 {
+		return "", nil, errors.New("invalid checksum")
+	}
+
+	decoded, err := convertBits(data[:len(data)-6], 5, 8, false)
+	if err != nil {
+		return "", nil, err
+	}
+	return hrp, decoded, nil
+}
+
+// EncodeBech32 encodes data bytes as a bech32 address with the given human readable prefix
+func EncodeBech32(hrp string, data []byte) (string, error) {
+	converted, err := convertBits(data, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+
+	polymod := bech32Polymod(append(append(bech32HrpExpand(hrp), converted...), 0, 0, 0, 0, 0, 0)) ^ 1
+	for i := 0; i < 6; i++ {
+		converted = append(converted, byte(polymod>>uint(5*(5-i))&31))
+	}
+
+	var encoded strings.Builder
+	encoded.WriteString(hrp)
+	encoded.WriteByte('1')
+	for _, value := range converted {
+		encoded.WriteByte(bech32Charset[value])
+	}
+	return encoded.String(), nil
+}
@@ -0,0 +1,184 @@
+package cctp
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestBase58(t *testing.T) {
+	tokenProgram, err := ParseSolanaAddress(SolanaTokenProgram)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if common.Bytes2Hex(tokenProgram[:]) != "06ddf6e1d765a193d9cbe146ceeb79ac1cb485ed5f5b37913a8cf5857eff00a9" {
+		t.Errorf("unexpected token program bytes %x", tokenProgram)
+	}
+	if EncodeBase58(tokenProgram[:]) != SolanaTokenProgram {
+		t.Errorf("base58 round trip failed: %s", EncodeBase58(tokenProgram[:]))
+	}
+
+	systemProgram, err := ParseSolanaAddress("11111111111111111111111111111111")
+	if err != nil || systemProgram != ([32]byte{}) {
+		t.Errorf("expected the system program to decode to zeros, got %x, %v", systemProgram, err)
+	}
+
+	for _, invalid := range []string{"", "0OIl", "1111"} {
+		if _, err := ParseSolanaAddress(invalid); err == nil {
+			t.Errorf("expected an error for %q", invalid)
+		}
+	}
+}
+
+func TestIsOnCurve(t *testing.T) {
+	for i := 0; i < 16; i++ {
+		publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var point [32]byte
+		copy(point[:], publicKey)
+		if !isOnCurve(point) {
+			t.Errorf("public key %x should be on the curve", point)
+		}
+	}
+}
+
+func TestCreateProgramAddress(t *testing.T) {
+	// Vectors of test_create_program_address in the Solana SDK
+	// Source: https://github.com/solana-labs/solana/blob/master/sdk/program/src/pubkey.rs
+	programID, err := ParseSolanaAddress("BPFLoaderUpgradeab1e11111111111111111111111")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	publicKey, err := ParseSolanaAddress("SeedPubey1111111111111111111111111111111111")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	testCases := []struct {
+		seeds    [][]byte
+		expected string
+	}{
+		{[][]byte{[]byte(""), {1}}, "BwqrghZA2htAcqq8dzP1WDAhTXYTYWj7CHxF5j7TDBAe"},
+		{[][]byte{[]byte("☉"), {0}}, "13yWmRpaTR4r5nAktwLqMpRNr28tnVUZw26rTvPSSB19"},
+		{[][]byte{[]byte("Talking"), []byte("Squirrels")}, "2fnQrngrQT4SeLcdToJAD96phoEjNL2man2kfRLCASVk"},
+		{[][]byte{publicKey[:], {1}}, "976ymqVnfE32QFe6NfGDctSvVa36LWnvYxhU6G2232YL"},
+	}
+	for _, testCase := range testCases {
+		address := createProgramAddress(testCase.seeds, programID)
+		if EncodeBase58(address[:]) != testCase.expected {
+			t.Errorf("seeds %q: expected %s, got %s", testCase.seeds, testCase.expected, EncodeBase58(address[:]))
+		}
+	}
+}
+
+func TestSolanaMintRecipient(t *testing.T) {
+	owner := "9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM"
+
+	mainnet, err := ParseMintRecipient(owner, ChainDomainSolana, false, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if EncodeBase58(mainnet[:]) != "FGETo8T8wMcN2wCjav8VK6eh3dLk63evNDPxzLSJra8B" {
+		t.Errorf("unexpected USDC associated token account %s", EncodeBase58(mainnet[:]))
+	}
+
+	// The address of bump 255 is on the curve, so the search has to go on to bump 254
+	ownerKey, _ := ParseSolanaAddress(owner)
+	tokenProgram, _ := ParseSolanaAddress(SolanaTokenProgram)
+	mint, _ := ParseSolanaAddress(DomainByID(ChainDomainSolana).Mainnet.NativeUSDC)
+	associatedTokenProgram, _ := ParseSolanaAddress(SolanaAssociatedTokenProgram)
+	address, bump, err := FindProgramAddress([][]byte{ownerKey[:], tokenProgram[:], mint[:]}, associatedTokenProgram)
+	if err != nil || address != mainnet || bump != 254 {
+		t.Errorf("expected the associated token account with bump 254, got %s with bump %d: %v", EncodeBase58(address[:]), bump, err)
+	}
+	if !isOnCurve(createProgramAddress([][]byte{ownerKey[:], tokenProgram[:], mint[:], {255}}, associatedTokenProgram)) {
+		t.Error("expected the address of bump 255 to be on the curve")
+	}
+	devnet, err := ParseMintRecipient(owner, ChainDomainSolana, true, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mainnet == devnet {
+		t.Error("mainnet and devnet token accounts should differ")
+	}
+	if isOnCurve(mainnet) || isOnCurve(devnet) {
+		t.Error("associated token accounts must be off the curve")
+	}
+
+	tokenAccount, err := ParseMintRecipient(owner, ChainDomainSolana, false, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if FormatMintRecipient(tokenAccount, ChainDomainSolana) != owner {
+		t.Errorf("expected the token account to be used as is, got %s", FormatMintRecipient(tokenAccount, ChainDomainSolana))
+	}
+}
+
+func TestBech32(t *testing.T) {
+	// Valid checksums from BIP-173
+	for _, valid := range []string{"A12UEL5L", "abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxw"} {
+		if _, _, err := DecodeBech32(valid); err != nil {
+			t.Errorf("%s: unexpected error: %v", valid, err)
+		}
+	}
+
+	address := common.HexToAddress("0x00112233445566778899aabbccddeeff00112233")
+	encoded, err := EncodeBech32(NobleAddressPrefix, address.Bytes())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	mintRecipient, err := ParseMintRecipient(encoded, ChainDomainNoble, false, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if common.BytesToAddress(mintRecipient[12:]) != address || mintRecipient[0] != 0 {
+		t.Errorf("unexpected mint recipient %x", mintRecipient)
+	}
+	if FormatMintRecipient(mintRecipient, ChainDomainNoble) != encoded {
+		t.Errorf("unexpected formatted recipient %s", FormatMintRecipient(mintRecipient, ChainDomainNoble))
+	}
+
+	corrupted := encoded[:len(encoded)-1] + "q"
+	if encoded[len(encoded)-1] == 'q' {
+		corrupted = encoded[:len(encoded)-1] + "p"
+	}
+	if _, err := ParseMintRecipient(corrupted, ChainDomainNoble, false, false); err == nil {
+		t.Error("expected a checksum error")
+	}
+
+	cosmos, _ := EncodeBech32("cosmos", address.Bytes())
+	if _, err := ParseMintRecipient(cosmos, ChainDomainNoble, false, false); err == nil {
+		t.Error("expected an error for a non Noble prefix")
+	}
+}
+
+func TestHexMintRecipients(t *testing.T) {
+	suiAddress := "0x" + "ab" + "00112233445566778899aabbccddeeff00112233445566778899aabbccddee"
+	mintRecipient, err := ParseMintRecipient(suiAddress, ChainDomainSui, false, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if "0x"+common.Bytes2Hex(mintRecipient[:]) != suiAddress {
+		t.Errorf("unexpected mint recipient %x", mintRecipient)
+	}
+	if _, err := ParseMintRecipient("0x1234", ChainDomainSui, false, false); err == nil {
+		t.Error("expected an error for a short Sui address")
+	}
+
+	evmAddress := common.HexToAddress("0x19330d10D9Cc8751218eaf51E8885D058642E08A")
+	mintRecipient, err = ParseMintRecipient(evmAddress.Hex(), ChainDomainBase, false, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if common.BytesToAddress(mintRecipient[12:]) != evmAddress {
+		t.Errorf("unexpected mint recipient %x", mintRecipient)
+	}
+	if _, err := ParseMintRecipient(suiAddress, ChainDomainBase, false, false); err == nil {
+		t.Error("expected an error for a 32 bytes address on an EVM domain")
+	}
+}
@@ -0,0 +1,254 @@
+package cctp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/G7DAO/bifrost/bindings/TokenMessenger"
+	"github.com/G7DAO/bifrost/cmd/safe"
+	"github.com/G7DAO/bifrost/cmd/transaction"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/cobra"
+)
+
+func GetReplaceDepositForBurnCalldata(originalMessage []byte, originalAttestation []byte, newDestinationCaller [32]byte, newMintRecipient [32]byte) ([]byte, error) {
+	abi, err := TokenMessenger.TokenMessengerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.Pack("replaceDepositForBurn", originalMessage, originalAttestation, newDestinationCaller, newMintRecipient)
+}
+
+// cctpReplace replaces the destination caller and mint recipient of a burn message that was not
+// received yet. Only the original sender of the burn can replace it, so the replacement is proposed to
+// the Safe if the burn was sent by one.
+func cctpReplace(key *keystore.Key, client *ethclient.Client, message *Message, newDestinationCaller [32]byte, newMintRecipient [32]byte, contractAddress common.Address, attestationApi string, pollInterval time.Duration, timeout time.Duration, safeFlags *safe.Flags, txFlags *transaction.Flags) error {
+	burnMessage, err := ParseBurnMessage(message.Body)
+	if err != nil {
+		return fmt.Errorf("message is not a depositForBurn message: %v", err)
+	}
+
+	destinationDomain := ChainDomain(message.DestinationDomain)
+	fmt.Println("Message hash:", message.Hash().Hex())
+	fmt.Println("Mint recipient:", FormatMintRecipient(burnMessage.MintRecipient, destinationDomain), "->", FormatMintRecipient(newMintRecipient, destinationDomain))
+	fmt.Println("Destination caller:", formatDestinationCaller(message.DestinationCaller, destinationDomain), "->", formatDestinationCaller(newDestinationCaller, destinationDomain))
+
+	sender := key.Address
+	if safeFlags.IsSet() {
+		sender = safeFlags.Address
+	}
+	originalSender := common.BytesToAddress(burnMessage.MessageSender[12:])
+	if originalSender != sender {
+		return fmt.Errorf("only the original sender %s can replace the message", originalSender.Hex())
+	}
+
+	attestation, err := WaitForAttestation(context.Background(), attestationApi, message.Hash(), pollInterval, timeout)
+	if err != nil {
+		return err
+	}
+
+	replaceData, err := GetReplaceDepositForBurnCalldata(message.Raw, attestation.Bytes(), newDestinationCaller, newMintRecipient)
+	if err != nil {
+		return err
+	}
+
+	if safeFlags.IsSet() {
+		return safe.CreateSafeProposal(client, key, safeFlags.Address, contractAddress, replaceData, big.NewInt(0), safeFlags.Api, safe.Call, safeFlags.Nonce)
+	}
+
+	tx, err := transaction.Send(context.Background(), client, key, contractAddress, big.NewInt(0), replaceData, txFlags)
+	if err != nil {
+		return err
+	}
+
+	if !txFlags.Unsigned() {
+		fmt.Println("Transaction hash:", tx.Hash().Hex())
+	}
+	fmt.Println("The replaced message must be received with `cctp receive` on the replacement transaction")
+	return nil
+}
+
+func formatDestinationCaller(destinationCaller [32]byte, domain ChainDomain) string {
+	if destinationCaller == ([32]byte{}) {
+		return "anyone"
+	}
+	return FormatMintRecipient(destinationCaller, domain)
+}
+
+func CreateReplaceCommand() *cobra.Command {
+	var keyFile, password, rpc, contractRaw, attestationApi, newRecipientRaw, newDestinationCallerRaw string
+	var messageIndex int
+	var recipientIsTokenAccount, clearDestinationCaller bool
+	var pollInterval, timeout time.Duration
+	var sourceTxHash common.Hash
+	var contract common.Address
+	var message *Message
+	var newMintRecipient, newDestinationCaller [32]byte
+	safeFlags := &safe.Flags{}
+	txFlags := &transaction.Flags{}
+
+	replaceCmd := &cobra.Command{
+		Use:   "replace <source-tx-hash>",
+		Short: "Replace the mint recipient or destination caller of a CCTP transfer",
+		Long: `Replace the mint recipient or destination caller of a CCTP transfer
+
+Calls TokenMessenger.replaceDepositForBurn with the original message and its attestation, e.g. when the
+destination caller cannot relay the message. The original message stays valid until either message is
+received on the destination domain.`,
+		Args: cobra.ExactArgs(1),
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if !sourceTxHashRegex.MatchString(args[0]) {
+				return errors.New("invalid source transaction hash")
+			}
+			sourceTxHash = common.HexToHash(args[0])
+
+			if keyFile == "" && !txFlags.Unsigned() {
+				return errors.New("keyfile is required")
+			}
+
+			if rpc == "" {
+				return errors.New("rpc is required")
+			}
+
+			if newRecipientRaw == "" && newDestinationCallerRaw == "" && !clearDestinationCaller {
+				return errors.New("--new-recipient, --new-destination-caller or --clear-destination-caller is required")
+			}
+
+			if newDestinationCallerRaw != "" && clearDestinationCaller {
+				return errors.New("--new-destination-caller and --clear-destination-caller cannot be used together")
+			}
+
+			if pollInterval <= 0 {
+				return errors.New("poll interval must be positive")
+			}
+
+			sourceClient, clientErr := ethclient.Dial(rpc)
+			if clientErr != nil {
+				return clientErr
+			}
+
+			sourceChainID, chainIDErr := sourceClient.ChainID(context.Background())
+			if chainIDErr != nil {
+				return chainIDErr
+			}
+
+			contractSet := contractRaw != ""
+			if !contractSet {
+				sourceDomain, sourceContracts, sourceErr := DomainByChainID(sourceChainID.Uint64())
+				if sourceErr != nil {
+					return fmt.Errorf("%v, --contract is required", sourceErr)
+				}
+				contractRaw = sourceContracts.TokenMessenger.Hex()
+				fmt.Println("--contract not specified, using TokenMessenger on", sourceDomain.Name, "(", contractRaw, ")")
+			}
+			if !common.IsHexAddress(contractRaw) {
+				return errors.New("invalid contract address")
+			}
+			contract = common.HexToAddress(contractRaw)
+
+			// The message is read from the MessageTransmitter of --contract, or the known one of the
+			// source chain
+			var tokenMessengerAddress *common.Address
+			if contractSet {
+				tokenMessengerAddress = &contract
+			}
+			sourceTransmitter, transmitterErr := SourceMessageTransmitter(sourceClient, sourceChainID.Uint64(), tokenMessengerAddress)
+			if transmitterErr != nil {
+				return transmitterErr
+			}
+
+			var messageErr error
+			message, messageErr = GetSourceMessage(sourceClient, sourceTransmitter, sourceTxHash, messageIndex)
+			if messageErr != nil {
+				return messageErr
+			}
+
+			burnMessage, burnMessageErr := ParseBurnMessage(message.Body)
+			if burnMessageErr != nil {
+				return fmt.Errorf("message is not a depositForBurn message: %v", burnMessageErr)
+			}
+			destinationDomain := ChainDomain(message.DestinationDomain)
+
+			newMintRecipient = burnMessage.MintRecipient
+			if newRecipientRaw != "" {
+				var recipientErr error
+				newMintRecipient, recipientErr = ParseMintRecipient(newRecipientRaw, destinationDomain, IsTestnet(sourceChainID.Uint64()), recipientIsTokenAccount)
+				if recipientErr != nil {
+					return recipientErr
+				}
+			}
+
+			newDestinationCaller = message.DestinationCaller
+			if clearDestinationCaller {
+				newDestinationCaller = [32]byte{}
+			} else if newDestinationCallerRaw != "" {
+				var destinationCallerErr error
+				newDestinationCaller, destinationCallerErr = ParseDestinationAddress(newDestinationCallerRaw, destinationDomain)
+				if destinationCallerErr != nil {
+					return fmt.Errorf("invalid destination caller: %v", destinationCallerErr)
+				}
+			}
+
+			if newMintRecipient == burnMessage.MintRecipient && newDestinationCaller == message.DestinationCaller {
+				return errors.New("the replacement does not change the mint recipient nor the destination caller")
+			}
+
+			if attestationApi == "" {
+				attestationApi = DefaultAttestationApi(sourceChainID.Uint64())
+			}
+
+			if safeErr := safeFlags.Parse(rpc); safeErr != nil {
+				return safeErr
+			}
+			if safeFlags.IsSet() && txFlags.Unsigned() {
+				return errors.New("--unsigned-out cannot be used with --safe, the Safe transaction is proposed instead")
+			}
+			if safeFlags.IsSet() && safe.OperationType(safeFlags.Operation) != safe.Call {
+				return errors.New("--safe-operation is not supported, replaceDepositForBurn is proposed as a Call")
+			}
+
+			if txErr := txFlags.Parse(); txErr != nil {
+				return txErr
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := txFlags.Key(keyFile, password)
+			if err != nil {
+				return err
+			}
+
+			client, err := ethclient.Dial(rpc)
+			if err != nil {
+				return err
+			}
+
+			return cctpReplace(key, client, message, newDestinationCaller, newMintRecipient, contract, attestationApi, pollInterval, timeout, safeFlags, txFlags)
+		},
+	}
+
+	replaceCmd.Flags().StringVar(&password, "password", "", "Password to decrypt the keyfile with")
+	replaceCmd.Flags().StringVar(&keyFile, "keyfile", "", "Keyfile to sign the replaceDepositForBurn transaction with")
+	replaceCmd.Flags().StringVar(&rpc, "rpc", "", "RPC URL of the source domain")
+	replaceCmd.Flags().StringVar(&contractRaw, "contract", "", "TokenMessenger the tokens were burned with (optional, defaults to the TokenMessenger on the source chain)")
+	replaceCmd.Flags().StringVar(&newRecipientRaw, "new-recipient", "", "New recipient in the destination domain (optional, defaults to the original mint recipient)")
+	replaceCmd.Flags().BoolVar(&recipientIsTokenAccount, "recipient-token-account", false, "For Solana, mint to --new-recipient as a USDC token account instead of to the associated token account of the --new-recipient wallet")
+	replaceCmd.Flags().StringVar(&newDestinationCallerRaw, "new-destination-caller", "", "New destination caller (optional, defaults to the original destination caller)")
+	replaceCmd.Flags().BoolVar(&clearDestinationCaller, "clear-destination-caller", false, "Let anyone receive the replaced message")
+	replaceCmd.Flags().StringVar(&attestationApi, "attestation-api", "", "Attestation service URL (optional, defaults to Circle's attestation service for the source network)")
+	replaceCmd.Flags().IntVar(&messageIndex, "message-index", 0, "Index of the message to replace, if the source transaction emitted several")
+	replaceCmd.Flags().DurationVar(&pollInterval, "poll-interval", DefaultAttestationPollInterval, "Interval between attestation requests")
+	replaceCmd.Flags().DurationVar(&timeout, "timeout", DefaultAttestationTimeout, "Maximum time to wait for the attestation")
+	safeFlags.AddFlags(replaceCmd)
+	txFlags.AddFlags(replaceCmd)
+
+	return replaceCmd
+}
@@ -0,0 +1,153 @@
+package cctp
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+const (
+	SolanaTokenProgram           = "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"
+	SolanaAssociatedTokenProgram = "ATokenGPvbdGVxr1b2hvZbsiqW5xWH25efTNsLJA8knL"
+)
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// DecodeBase58 decodes a base58 string with the Bitcoin alphabet, which Solana uses for addresses
+func DecodeBase58(encoded string) ([]byte, error) {
+	if encoded == "" {
+		return nil, errors.New("empty base58 string")
+	}
+
+	value := new(big.Int)
+	radix := big.NewInt(58)
+	for _, c := range encoded {
+		digit := strings.IndexRune(base58Alphabet, c)
+		if digit < 0 {
+			return nil, fmt.Errorf("invalid base58 character %q", c)
+		}
+		value.Mul(value, radix)
+		value.Add(value, big.NewInt(int64(digit)))
+	}
+
+	// Every leading '1' encodes a leading zero byte
+	leadingZeros := 0
+	for leadingZeros < len(encoded) && encoded[leadingZeros] == '1' {
+		leadingZeros++
+	}
+
+	return append(make([]byte, leadingZeros), value.Bytes()...), nil
+}
+
+// EncodeBase58 encodes bytes as a base58 string with the Bitcoin alphabet
+func EncodeBase58(data []byte) string {
+	value := new(big.Int).SetBytes(data)
+	radix := big.NewInt(58)
+	modulo := new(big.Int)
+
+	var encoded []byte
+	for value.Sign() > 0 {
+		value.DivMod(value, radix, modulo)
+		encoded = append(encoded, base58Alphabet[modulo.Int64()])
+	}
+	for i := 0; i < len(data) && data[i] == 0; i++ {
+		encoded = append(encoded, '1')
+	}
+
+	for i, j := 0, len(encoded)-1; i < j; i, j = i+1, j-1 {
+		encoded[i], encoded[j] = encoded[j], encoded[i]
+	}
+	return string(encoded)
+}
+
+// ParseSolanaAddress decodes a base58 Solana address into its 32 bytes
+func ParseSolanaAddress(address string) ([32]byte, error) {
+	var publicKey [32]byte
+
+	decoded, err := DecodeBase58(address)
+	if err != nil {
+		return publicKey, fmt.Errorf("invalid Solana address %s: %v", address, err)
+	}
+	if len(decoded) != 32 {
+		return publicKey, fmt.Errorf("invalid Solana address %s: expected 32 bytes, got %d", address, len(decoded))
+	}
+
+	copy(publicKey[:], decoded)
+	return publicKey, nil
+}
+
+var (
+	// Field prime and curve constant of ed25519, -x^2 + y^2 = 1 + d*x^2*y^2 mod p
+	ed25519P = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(19))
+	ed25519D = new(big.Int).Mod(new(big.Int).Mul(big.NewInt(-121665), new(big.Int).ModInverse(big.NewInt(121666), ed25519P)), ed25519P)
+)
+
+// isOnCurve returns true if the 32 bytes decompress to a point of ed25519. Program derived addresses
+// must not be on the curve, so that no private key can sign for them.
+func isOnCurve(point [32]byte) bool {
+	// Compressed points are the little endian y coordinate, with the sign of x in the top bit
+	littleEndian := point
+	littleEndian[31] &= 0x7f
+	bigEndian := make([]byte, 32)
+	for i := range littleEndian {
+		bigEndian[31-i] = littleEndian[i]
+	}
+	y := new(big.Int).Mod(new(big.Int).SetBytes(bigEndian), ed25519P)
+
+	// x^2 = (y^2 - 1) / (d*y^2 + 1) must be a square
+	ySquared := new(big.Int).Mod(new(big.Int).Mul(y, y), ed25519P)
+	u := new(big.Int).Mod(new(big.Int).Sub(ySquared, big.NewInt(1)), ed25519P)
+	v := new(big.Int).Mod(new(big.Int).Add(new(big.Int).Mul(ed25519D, ySquared), big.NewInt(1)), ed25519P)
+	xSquared := new(big.Int).Mod(new(big.Int).Mul(u, new(big.Int).ModInverse(v, ed25519P)), ed25519P)
+
+	if xSquared.Sign() == 0 {
+		return true
+	}
+	return big.Jacobi(xSquared, ed25519P) == 1
+}
+
+// FindProgramAddress derives the program derived address of the seeds, using the highest bump seed
+// that puts the address off the curve
+// Source: https://github.com/solana-labs/solana/blob/master/sdk/program/src/pubkey.rs
+func FindProgramAddress(seeds [][]byte, programID [32]byte) ([32]byte, uint8, error) {
+	for bump := 255; bump >= 0; bump-- {
+		address := createProgramAddress(append(seeds[:len(seeds):len(seeds)], []byte{byte(bump)}), programID)
+		if !isOnCurve(address) {
+			return address, uint8(bump), nil
+		}
+	}
+	return [32]byte{}, 0, errors.New("could not find a program derived address")
+}
+
+// createProgramAddress hashes the seeds with the program ID, without checking that the address is off
+// the curve
+func createProgramAddress(seeds [][]byte, programID [32]byte) [32]byte {
+	hasher := sha256.New()
+	for _, seed := range seeds {
+		hasher.Write(seed)
+	}
+	hasher.Write(programID[:])
+	hasher.Write([]byte("ProgramDerivedAddress"))
+
+	var address [32]byte
+	copy(address[:], hasher.Sum(nil))
+	return address
+}
+
+// GetAssociatedTokenAddress derives the associated token account of the owner for the mint, which is
+// where CCTP mints USDC on Solana
+func GetAssociatedTokenAddress(owner [32]byte, mint [32]byte) ([32]byte, error) {
+	tokenProgram, err := ParseSolanaAddress(SolanaTokenProgram)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	associatedTokenProgram, err := ParseSolanaAddress(SolanaAssociatedTokenProgram)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	address, _, err := FindProgramAddress([][]byte{owner[:], tokenProgram[:], mint[:]}, associatedTokenProgram)
+	return address, err
+}
@@ -0,0 +1,320 @@
+package cctp
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/G7DAO/bifrost/bindings/MessageTransmitterV2"
+	"github.com/G7DAO/bifrost/bindings/TokenMessengerV2"
+	"github.com/G7DAO/bifrost/cmd/transaction"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// CCTP V2 contracts are deployed at the same addresses on every EVM domain supporting V2
+// Source: https://developers.circle.com/stablecoins/evm-smart-contracts
+var (
+	TokenMessengerV2Mainnet     = common.HexToAddress("0x28b5a0e9C621a5BadaA536219b3a228C8168cf5d")
+	MessageTransmitterV2Mainnet = common.HexToAddress("0x81D40F21F12A8F0E3252Bccb954D722d4c464B64")
+	TokenMessengerV2Testnet     = common.HexToAddress("0x8FE6B999Dc680CcFDD5Bf7EB0974218be2542DAA")
+	MessageTransmitterV2Testnet = common.HexToAddress("0xE737e5cEBEEBa77EFE34D4aa090756590b1CE275")
+)
+
+const (
+	// FinalityThresholdFast lets the attestation service attest the message before the burn is
+	// finalized, for a fee
+	FinalityThresholdFast uint32 = 1000
+	// FinalityThresholdStandard waits for the burn to be finalized
+	FinalityThresholdStandard uint32 = 2000
+)
+
+// V2Contracts returns the TokenMessengerV2 and MessageTransmitterV2 addresses of the network
+func V2Contracts(testnet bool) (common.Address, common.Address) {
+	if testnet {
+		return TokenMessengerV2Testnet, MessageTransmitterV2Testnet
+	}
+	return TokenMessengerV2Mainnet, MessageTransmitterV2Mainnet
+}
+
+// GetDepositForBurnV2Calldata packs TokenMessengerV2.depositForBurn, or depositForBurnWithHook if
+// hookData is set. A zero destinationCaller lets anyone receive the message.
+func GetDepositForBurnV2Calldata(mintRecipient [32]byte, domain uint32, amount *big.Int, token common.Address, destinationCaller [32]byte, maxFee *big.Int, minFinalityThreshold uint32, hookData []byte) ([]byte, error) {
+	abi, err := TokenMessengerV2.TokenMessengerV2MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(hookData) > 0 {
+		return abi.Pack("depositForBurnWithHook", amount, domain, mintRecipient, token, destinationCaller, maxFee, minFinalityThreshold, hookData)
+	}
+	return abi.Pack("depositForBurn", amount, domain, mintRecipient, token, destinationCaller, maxFee, minFinalityThreshold)
+}
+
+// FeeQuote is the fee charged by Circle for a transfer attested at FinalityThreshold, in basis
+// points of the amount
+type FeeQuote struct {
+	FinalityThreshold uint32      `json:"finalityThreshold"`
+	MinimumFee        json.Number `json:"minimumFee"`
+}
+
+// FetchFeeQuotes fetches the fees of transfers between the domains from the fee API
+func FetchFeeQuotes(ctx context.Context, httpClient *http.Client, feeApi string, sourceDomain uint32, destinationDomain uint32) ([]FeeQuote, error) {
+	requestURL := fmt.Sprintf("%s/v2/burn/USDC/fees/%d/%d", strings.TrimSuffix(feeApi, "/"), sourceDomain, destinationDomain)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch fees: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fee response: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fee API returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var quotes []FeeQuote
+	if err := json.Unmarshal(body, &quotes); err != nil {
+		return nil, fmt.Errorf("failed to parse fee response: %v", err)
+	}
+	return quotes, nil
+}
+
+// GetFeeQuote returns the quote applying to a transfer with the minimum finality threshold, which
+// is the one of the highest threshold not above it
+func GetFeeQuote(quotes []FeeQuote, minFinalityThreshold uint32) (*FeeQuote, error) {
+	var quote *FeeQuote
+	for i := range quotes {
+		if quotes[i].FinalityThreshold <= minFinalityThreshold && (quote == nil || quotes[i].FinalityThreshold > quote.FinalityThreshold) {
+			quote = &quotes[i]
+		}
+	}
+	if quote == nil {
+		return nil, fmt.Errorf("no fee quote for finality threshold %d", minFinalityThreshold)
+	}
+	return quote, nil
+}
+
+// MaxFee returns the fee of the quote for the amount, rounded up
+func (q *FeeQuote) MaxFee(amount *big.Int) (*big.Int, error) {
+	bps, ok := new(big.Rat).SetString(q.MinimumFee.String())
+	if !ok || bps.Sign() < 0 {
+		return nil, fmt.Errorf("invalid minimum fee %s", q.MinimumFee.String())
+	}
+
+	fee := new(big.Rat).Mul(new(big.Rat).SetInt(amount), bps)
+	fee.Quo(fee, big.NewRat(10000, 1))
+
+	quotient, remainder := new(big.Int).QuoRem(fee.Num(), fee.Denom(), new(big.Int))
+	if remainder.Sign() > 0 {
+		quotient.Add(quotient, big.NewInt(1))
+	}
+	return quotient, nil
+}
+
+// messageV2HeaderLength is the length of the fixed fields of a CCTP V2 message, before the body
+// Source: https://github.com/circlefin/evm-cctp-contracts/blob/master/src/messages/v2/MessageV2.sol
+const messageV2HeaderLength = 148
+
+// MessageV2 is a CCTP V2 message. The nonce is only assigned by the attestation service, so the
+// message emitted by MessageTransmitterV2.MessageSent cannot be received as is.
+type MessageV2 struct {
+	Version                   uint32
+	SourceDomain              uint32
+	DestinationDomain         uint32
+	Nonce                     [32]byte
+	Sender                    [32]byte
+	Recipient                 [32]byte
+	DestinationCaller         [32]byte
+	MinFinalityThreshold      uint32
+	FinalityThresholdExecuted uint32
+	Body                      []byte
+	Raw                       []byte
+}
+
+// ParseMessageV2 decodes the fixed fields of a CCTP V2 message
+func ParseMessageV2(raw []byte) (*MessageV2, error) {
+	if len(raw) < messageV2HeaderLength {
+		return nil, fmt.Errorf("invalid message length: expected at least %d bytes, got %d", messageV2HeaderLength, len(raw))
+	}
+
+	message := &MessageV2{
+		Version:                   binary.BigEndian.Uint32(raw[0:4]),
+		SourceDomain:              binary.BigEndian.Uint32(raw[4:8]),
+		DestinationDomain:         binary.BigEndian.Uint32(raw[8:12]),
+		MinFinalityThreshold:      binary.BigEndian.Uint32(raw[140:144]),
+		FinalityThresholdExecuted: binary.BigEndian.Uint32(raw[144:148]),
+		Body:                      raw[messageV2HeaderLength:],
+		Raw:                       raw,
+	}
+	copy(message.Nonce[:], raw[12:44])
+	copy(message.Sender[:], raw[44:76])
+	copy(message.Recipient[:], raw[76:108])
+	copy(message.DestinationCaller[:], raw[108:140])
+
+	return message, nil
+}
+
+// Hash returns the keccak256 hash of the message
+func (m *MessageV2) Hash() common.Hash {
+	return crypto.Keccak256Hash(m.Raw)
+}
+
+// AttestedMessageV2 is a message of a source transaction as returned by the V2 messages API
+type AttestedMessageV2 struct {
+	Message     string `json:"message"`
+	Attestation string `json:"attestation"`
+	Status      string `json:"status"`
+}
+
+// FetchMessagesV2 fetches the messages emitted by the source transaction, with their attestations.
+// A transaction that the attestation service has not seen yet has no messages rather than an error.
+func FetchMessagesV2(ctx context.Context, httpClient *http.Client, attestationApi string, sourceDomain uint32, sourceTxHash common.Hash) ([]AttestedMessageV2, error) {
+	requestURL := fmt.Sprintf("%s/v2/messages/%d?transactionHash=%s", strings.TrimSuffix(attestationApi, "/"), sourceDomain, sourceTxHash.Hex())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch messages: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read messages response: %v", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("attestation service returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var response struct {
+		Messages []AttestedMessageV2 `json:"messages"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse messages response: %v", err)
+	}
+	return response.Messages, nil
+}
+
+// WaitForMessageV2 polls the attestation service every pollInterval until the message at
+// messageIndex of the source transaction is attested or the timeout expires
+func WaitForMessageV2(ctx context.Context, attestationApi string, sourceDomain uint32, sourceTxHash common.Hash, messageIndex int, pollInterval time.Duration, timeout time.Duration) (*MessageV2, []byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	httpClient := &http.Client{Timeout: 30 * time.Second}
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		messages, err := FetchMessagesV2(ctx, httpClient, attestationApi, sourceDomain, sourceTxHash)
+		if err != nil && ctx.Err() == nil {
+			fmt.Println("Failed to fetch messages, retrying:", err.Error())
+		} else if err == nil {
+			if messageIndex >= len(messages) {
+				fmt.Println("Attestation status: not found yet")
+			} else if messages[messageIndex].Status != AttestationStatusComplete {
+				fmt.Println("Attestation status:", messages[messageIndex].Status)
+			} else {
+				message, parseErr := ParseMessageV2(common.FromHex(messages[messageIndex].Message))
+				if parseErr != nil {
+					return nil, nil, parseErr
+				}
+				return message, common.FromHex(messages[messageIndex].Attestation), nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, nil, fmt.Errorf("timed out waiting for attestation of message %d of %s", messageIndex, sourceTxHash.Hex())
+		case <-ticker.C:
+		}
+	}
+}
+
+// cctpReceiveV2 waits for the attested message of the source transaction and calls
+// MessageTransmitterV2.receiveMessage with it
+func cctpReceiveV2(key *keystore.Key, destinationClient *ethclient.Client, sourceDomain uint32, sourceTxHash common.Hash, messageIndex int, transmitterAddress common.Address, attestationApi string, pollInterval time.Duration, timeout time.Duration, txFlags *transaction.Flags) error {
+	transmitter, err := MessageTransmitterV2.NewMessageTransmitterV2(transmitterAddress, destinationClient)
+	if err != nil {
+		return err
+	}
+
+	localDomain, err := transmitter.LocalDomain(&bind.CallOpts{})
+	if err != nil {
+		return fmt.Errorf("failed to fetch local domain of MessageTransmitterV2: %v", err)
+	}
+
+	message, attestation, err := WaitForMessageV2(context.Background(), attestationApi, sourceDomain, sourceTxHash, messageIndex, pollInterval, timeout)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Message hash:", message.Hash().Hex())
+	fmt.Println("Source domain:", ChainDomain(message.SourceDomain).String(), "Destination domain:", ChainDomain(message.DestinationDomain).String(), "Nonce:", common.Hash(message.Nonce).Hex())
+	fmt.Println("Finality threshold executed:", message.FinalityThresholdExecuted)
+
+	if localDomain != message.DestinationDomain {
+		return fmt.Errorf("message is for domain %d, but the MessageTransmitterV2 on the destination RPC is on domain %d", message.DestinationDomain, localDomain)
+	}
+
+	used, err := transmitter.UsedNonces(&bind.CallOpts{}, message.Nonce)
+	if err != nil {
+		return fmt.Errorf("failed to check if nonce %s was used: %v", common.Hash(message.Nonce).Hex(), err)
+	}
+	if used.Sign() != 0 {
+		fmt.Println("Message was already received on the destination domain")
+		return nil
+	}
+
+	if message.DestinationCaller != ([32]byte{}) {
+		caller, _ := ParseMintRecipientFrom20BytesTo32Bytes(key.Address)
+		if caller != message.DestinationCaller {
+			return fmt.Errorf("message can only be received by destination caller 0x%x", message.DestinationCaller)
+		}
+	}
+
+	transmitterAbi, err := MessageTransmitterV2.MessageTransmitterV2MetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+	receiveMessageData, err := transmitterAbi.Pack("receiveMessage", message.Raw, attestation)
+	if err != nil {
+		return err
+	}
+
+	tx, err := transaction.Send(context.Background(), destinationClient, key, transmitterAddress, big.NewInt(0), receiveMessageData, txFlags)
+	if err != nil {
+		return err
+	}
+
+	if !txFlags.Unsigned() {
+		fmt.Println("Transaction hash:", tx.Hash().Hex())
+	}
+	return nil
+}
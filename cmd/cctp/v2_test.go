@@ -0,0 +1,179 @@
+package cctp
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestFetchFeeQuotes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/burn/USDC/fees/0/6" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		io.WriteString(w, `[{"finalityThreshold": 1000, "minimumFee": 1.3}, {"finalityThreshold": 2000, "minimumFee": 0}]`)
+	}))
+	defer server.Close()
+
+	quotes, err := FetchFeeQuotes(context.Background(), http.DefaultClient, server.URL, uint32(ChainDomainEthereum), uint32(ChainDomainBase))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		threshold uint32
+		amount    int64
+		maxFee    int64
+	}{
+		// 1.3 bps of 1000000 is 130
+		{FinalityThresholdFast, 1000000, 130},
+		// 1.3 bps of 1001 is 0.13013, rounded up
+		{FinalityThresholdFast, 1001, 1},
+		{1500, 1000000, 130},
+		{FinalityThresholdStandard, 1000000, 0},
+	}
+	for _, test := range tests {
+		quote, quoteErr := GetFeeQuote(quotes, test.threshold)
+		if quoteErr != nil {
+			t.Fatalf("threshold %d: unexpected error: %v", test.threshold, quoteErr)
+		}
+		maxFee, feeErr := quote.MaxFee(big.NewInt(test.amount))
+		if feeErr != nil {
+			t.Fatalf("threshold %d: unexpected error: %v", test.threshold, feeErr)
+		}
+		if maxFee.Int64() != test.maxFee {
+			t.Errorf("threshold %d, amount %d: expected max fee %d, got %s", test.threshold, test.amount, test.maxFee, maxFee.String())
+		}
+	}
+
+	if _, err := GetFeeQuote(quotes, 999); err == nil {
+		t.Error("expected an error for a threshold below every quote")
+	}
+}
+
+func TestFetchFeeQuotesError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, `{"error": "Invalid domain"}`)
+	}))
+	defer server.Close()
+
+	if _, err := FetchFeeQuotes(context.Background(), http.DefaultClient, server.URL, 0, 42); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestWaitForMessageV2(t *testing.T) {
+	sourceTxHash := common.HexToHash("0x3333333333333333333333333333333333333333333333333333333333333333")
+
+	raw := make([]byte, messageV2HeaderLength+4)
+	binary.BigEndian.PutUint32(raw[0:4], 1)
+	binary.BigEndian.PutUint32(raw[4:8], uint32(ChainDomainEthereum))
+	binary.BigEndian.PutUint32(raw[8:12], uint32(ChainDomainBase))
+	raw[43] = 7
+	binary.BigEndian.PutUint32(raw[140:144], FinalityThresholdFast)
+	binary.BigEndian.PutUint32(raw[144:148], FinalityThresholdFast)
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/messages/0" || r.URL.Query().Get("transactionHash") != sourceTxHash.Hex() {
+			t.Errorf("unexpected request %s", r.URL.String())
+		}
+
+		requests++
+		switch requests {
+		case 1:
+			w.WriteHeader(http.StatusNotFound)
+		case 2:
+			io.WriteString(w, `{"messages": [{"message": "0x", "attestation": "PENDING", "status": "pending_confirmations"}]}`)
+		default:
+			io.WriteString(w, `{"messages": [{"message": "0x`+common.Bytes2Hex(raw)+`", "attestation": "0xabcd", "status": "complete"}]}`)
+		}
+	}))
+	defer server.Close()
+
+	message, attestation, err := WaitForMessageV2(context.Background(), server.URL, uint32(ChainDomainEthereum), sourceTxHash, 0, time.Millisecond, time.Second)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if common.Bytes2Hex(attestation) != "abcd" {
+		t.Errorf("unexpected attestation %x", attestation)
+	}
+	if ChainDomain(message.SourceDomain) != ChainDomainEthereum || ChainDomain(message.DestinationDomain) != ChainDomainBase {
+		t.Errorf("unexpected domains %d -> %d", message.SourceDomain, message.DestinationDomain)
+	}
+	if message.Nonce[31] != 7 || message.FinalityThresholdExecuted != FinalityThresholdFast || len(message.Body) != 4 {
+		t.Errorf("unexpected message %+v", message)
+	}
+	if message.Hash() != crypto.Keccak256Hash(raw) {
+		t.Errorf("unexpected message hash %s", message.Hash().Hex())
+	}
+}
+
+func TestEncodeHookData(t *testing.T) {
+	recipient := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	hookData, err := EncodeHookData("deposit(address, uint256,uint8,bytes32)", []string{recipient.Hex(), "1000", "255", "0x" + common.Bytes2Hex(make([]byte, 32))})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	selector := crypto.Keccak256([]byte("deposit(address,uint256,uint8,bytes32)"))[:4]
+	if common.Bytes2Hex(hookData[:4]) != common.Bytes2Hex(selector) {
+		t.Errorf("unexpected selector %x", hookData[:4])
+	}
+	if len(hookData) != 4+4*32 {
+		t.Fatalf("unexpected hook data length %d", len(hookData))
+	}
+	if common.BytesToAddress(hookData[4:36]) != recipient {
+		t.Errorf("unexpected address argument %x", hookData[4:36])
+	}
+	if new(big.Int).SetBytes(hookData[36:68]).Int64() != 1000 || hookData[99] != 255 {
+		t.Errorf("unexpected integer arguments %x", hookData[36:100])
+	}
+
+	invalid := []struct {
+		signature string
+		args      []string
+	}{
+		{"deposit", nil},
+		{"deposit(address)", nil},
+		{"deposit(address)", []string{"0x1234"}},
+		{"deposit(uint8)", []string{"256"}},
+		{"deposit(uint256)", []string{"-1"}},
+		{"deposit(int8)", []string{"128"}},
+		{"deposit(bytes4)", []string{"0x1234"}},
+		{"deposit(unknown)", []string{"1"}},
+	}
+	for _, test := range invalid {
+		if _, err := EncodeHookData(test.signature, test.args); err == nil {
+			t.Errorf("%s %v: expected an error", test.signature, test.args)
+		}
+	}
+
+	// Negative integers are encoded in two's complement over the whole word
+	encoded, err := EncodeHookData("deposit(int256,int8,int8)", []string{"-5", "-1", "-128"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectedWords := []string{
+		"fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffb",
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff80",
+	}
+	if len(encoded) != 4+32*len(expectedWords) {
+		t.Fatalf("unexpected hook data length %d", len(encoded))
+	}
+	for i, expected := range expectedWords {
+		if word := common.Bytes2Hex(encoded[4+32*i : 4+32*(i+1)]); word != expected {
+			t.Errorf("argument %d: expected %s, got %s", i, expected, word)
+		}
+	}
+}
@@ -6,6 +6,9 @@ import (
 	arbitrum_bifrost "github.com/G7DAO/bifrost/cmd/arbitrum"
 	"github.com/G7DAO/bifrost/cmd/base"
 	"github.com/G7DAO/bifrost/cmd/cctp"
+	"github.com/G7DAO/bifrost/cmd/opstack"
+	"github.com/G7DAO/bifrost/cmd/safe"
+	"github.com/G7DAO/bifrost/cmd/transaction"
 	"github.com/G7DAO/bifrost/cmd/version"
 	"github.com/spf13/cobra"
 )
@@ -26,8 +29,11 @@ func CreateRootCommand() *cobra.Command {
 	arbitrumCmd := arbitrum_bifrost.CreateArbitrumCommand()
 	cctpCmd := cctp.CreateCctpCommand()
 	baseCmd := base.CreateBaseCommand()
+	opstackCmd := opstack.CreateOpStackCommand()
+	safeCmd := safe.CreateSafeCommand()
+	txCmd := transaction.CreateTxCommand()
 
-	rootCmd.AddCommand(completionCmd, versionCmd, arbitrumCmd, cctpCmd, baseCmd)
+	rootCmd.AddCommand(completionCmd, versionCmd, arbitrumCmd, cctpCmd, baseCmd, opstackCmd, safeCmd, txCmd)
 
 	// By default, cobra Command objects write to stderr. We have to forcibly set them to output to
 	// stdout.
@@ -0,0 +1,307 @@
+package decode
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/G7DAO/bifrost/bindings/ArbSys"
+	"github.com/G7DAO/bifrost/bindings/ArbitrumL1OrbitCustomGateway"
+	"github.com/G7DAO/bifrost/bindings/ArbitrumL1OrbitGatewayRouter"
+	"github.com/G7DAO/bifrost/bindings/ArbitrumL2CustomGateway"
+	"github.com/G7DAO/bifrost/bindings/ArbitrumUpgradeExecutor"
+	"github.com/G7DAO/bifrost/bindings/DisputeGameFactory"
+	"github.com/G7DAO/bifrost/bindings/ERC20"
+	"github.com/G7DAO/bifrost/bindings/ERC20Inbox"
+	"github.com/G7DAO/bifrost/bindings/FaultDisputeGame"
+	"github.com/G7DAO/bifrost/bindings/GnosisSafe"
+	"github.com/G7DAO/bifrost/bindings/L1CrossDomainMessenger"
+	"github.com/G7DAO/bifrost/bindings/L1GatewayRouter"
+	"github.com/G7DAO/bifrost/bindings/L1StandardBridge"
+	"github.com/G7DAO/bifrost/bindings/L1Teleporter"
+	"github.com/G7DAO/bifrost/bindings/L2ForwarderFactory"
+	"github.com/G7DAO/bifrost/bindings/L2StandardBridge"
+	"github.com/G7DAO/bifrost/bindings/L2ToL1MessagePasser"
+	"github.com/G7DAO/bifrost/bindings/MessageTransmitter"
+	"github.com/G7DAO/bifrost/bindings/MessageTransmitterV2"
+	"github.com/G7DAO/bifrost/bindings/NodeInterface"
+	"github.com/G7DAO/bifrost/bindings/OptimismMintableERC20"
+	"github.com/G7DAO/bifrost/bindings/OptimismMintableERC20Factory"
+	"github.com/G7DAO/bifrost/bindings/OptimismPortal"
+	"github.com/G7DAO/bifrost/bindings/TokenMessenger"
+	"github.com/G7DAO/bifrost/bindings/TokenMessengerV2"
+	"github.com/G7DAO/bifrost/bindings/TokenMinter"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// BindingsMetaData lists the ABIs of every binding shipped in bindings/, by contract name
+var BindingsMetaData = map[string]*bind.MetaData{
+	"ArbSys":                       ArbSys.ArbSysMetaData,
+	"ArbitrumUpgradeExecutor":      ArbitrumUpgradeExecutor.ArbitrumUpgradeExecutorMetaData,
+	"DisputeGameFactory":           DisputeGameFactory.DisputeGameFactoryMetaData,
+	"ERC20":                        ERC20.ERC20MetaData,
+	"ERC20Inbox":                   ERC20Inbox.ERC20InboxMetaData,
+	"FaultDisputeGame":             FaultDisputeGame.FaultDisputeGameMetaData,
+	"GnosisSafe":                   GnosisSafe.GnosisSafeMetaData,
+	"L1CrossDomainMessenger":       L1CrossDomainMessenger.L1CrossDomainMessengerMetaData,
+	"L1GatewayRouter":              L1GatewayRouter.L1GatewayRouterMetaData,
+	"L1OrbitCustomGateway":         ArbitrumL1OrbitCustomGateway.L1OrbitCustomGatewayMetaData,
+	"L1OrbitGatewayRouter":         ArbitrumL1OrbitGatewayRouter.L1OrbitGatewayRouterMetaData,
+	"L1StandardBridge":             L1StandardBridge.L1StandardBridgeMetaData,
+	"L1Teleporter":                 L1Teleporter.L1TeleporterMetaData,
+	"L2CustomGateway":              ArbitrumL2CustomGateway.L2CustomGatewayMetaData,
+	"L2ForwarderFactory":           L2ForwarderFactory.L2ForwarderFactoryMetaData,
+	"L2StandardBridge":             L2StandardBridge.L2StandardBridgeMetaData,
+	"L2ToL1MessagePasser":          L2ToL1MessagePasser.L2ToL1MessagePasserMetaData,
+	"MessageTransmitter":           MessageTransmitter.MessageTransmitterMetaData,
+	"MessageTransmitterV2":         MessageTransmitterV2.MessageTransmitterV2MetaData,
+	"NodeInterface":                NodeInterface.NodeInterfaceMetaData,
+	"OptimismMintableERC20":        OptimismMintableERC20.OptimismMintableERC20MetaData,
+	"OptimismMintableERC20Factory": OptimismMintableERC20Factory.OptimismMintableERC20FactoryMetaData,
+	"OptimismPortal":               OptimismPortal.OptimismPortalMetaData,
+	"TokenMessenger":               TokenMessenger.TokenMessengerMetaData,
+	"TokenMessengerV2":             TokenMessengerV2.TokenMessengerV2MetaData,
+	"TokenMinter":                  TokenMinter.TokenMinterMetaData,
+}
+
+type methodEntry struct {
+	contracts []string
+	method    abi.Method
+}
+
+type errorEntry struct {
+	contracts []string
+	abiError  abi.Error
+}
+
+// Registry maps function and custom error selectors to the methods and errors of a set of ABIs.
+// Contracts that share a method (e.g. outboundTransfer on the gateway routers) or an error are
+// grouped under a single entry.
+type Registry struct {
+	methods map[[4]byte][]*methodEntry
+	errors  map[[4]byte][]*errorEntry
+}
+
+// Call is a decoded contract call
+type Call struct {
+	Contracts []string
+	Method    abi.Method
+	Values    []interface{}
+	Args      map[string]interface{}
+}
+
+// Name returns the call as Contract.method, listing every contract that defines the method
+func (c *Call) Name() string {
+	return strings.Join(c.Contracts, "|") + "." + c.Method.RawName
+}
+
+func NewRegistry() *Registry {
+	return &Registry{methods: make(map[[4]byte][]*methodEntry), errors: make(map[[4]byte][]*errorEntry)}
+}
+
+// NewBindingsRegistry returns a registry populated with every ABI in BindingsMetaData
+func NewBindingsRegistry() (*Registry, error) {
+	registry := NewRegistry()
+	for name, metaData := range BindingsMetaData {
+		parsedAbi, err := metaData.GetAbi()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s ABI: %v", name, err)
+		}
+		registry.Add(name, parsedAbi)
+	}
+	return registry, nil
+}
+
+// AddJSON parses abiJSON and adds its methods to the registry under the given contract name
+func (r *Registry) AddJSON(name string, abiJSON string) error {
+	parsedAbi, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return fmt.Errorf("failed to parse %s ABI: %v", name, err)
+	}
+	r.Add(name, &parsedAbi)
+	return nil
+}
+
+// Add adds the methods and errors of parsedAbi to the registry under the given contract name
+func (r *Registry) Add(name string, parsedAbi *abi.ABI) {
+	for _, method := range parsedAbi.Methods {
+		var selector [4]byte
+		copy(selector[:], method.ID)
+
+		found := false
+		for _, entry := range r.methods[selector] {
+			if entry.method.Sig == method.Sig {
+				entry.contracts = insertSorted(entry.contracts, name)
+				found = true
+				break
+			}
+		}
+		if !found {
+			r.methods[selector] = append(r.methods[selector], &methodEntry{contracts: []string{name}, method: method})
+		}
+	}
+
+	for _, abiError := range parsedAbi.Errors {
+		var selector [4]byte
+		copy(selector[:], abiError.ID[:4])
+
+		found := false
+		for _, entry := range r.errors[selector] {
+			if entry.abiError.Sig == abiError.Sig {
+				entry.contracts = insertSorted(entry.contracts, name)
+				found = true
+				break
+			}
+		}
+		if !found {
+			r.errors[selector] = append(r.errors[selector], &errorEntry{contracts: []string{name}, abiError: abiError})
+		}
+	}
+}
+
+func insertSorted(names []string, name string) []string {
+	for i, n := range names {
+		if n == name {
+			return names
+		}
+		if n > name {
+			return append(names[:i], append([]string{name}, names[i:]...)...)
+		}
+	}
+	return append(names, name)
+}
+
+// DecodeCall decodes calldata against every method registered under its selector and returns the
+// first one whose arguments unpack cleanly
+func (r *Registry) DecodeCall(data []byte) (*Call, error) {
+	if len(data) < 4 {
+		return nil, errors.New("calldata is shorter than a function selector")
+	}
+
+	var selector [4]byte
+	copy(selector[:], data[:4])
+
+	entries, ok := r.methods[selector]
+	if !ok {
+		return nil, fmt.Errorf("unknown function selector 0x%s", hex.EncodeToString(selector[:]))
+	}
+
+	var lastErr error
+	for _, entry := range entries {
+		values, err := entry.method.Inputs.Unpack(data[4:])
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		args := make(map[string]interface{})
+		for i, input := range entry.method.Inputs {
+			args[input.Name] = values[i]
+		}
+		return &Call{Contracts: entry.contracts, Method: entry.method, Values: values, Args: args}, nil
+	}
+
+	return nil, fmt.Errorf("failed to decode arguments of selector 0x%s: %v", hex.EncodeToString(selector[:]), lastErr)
+}
+
+// panicSelector is the selector of Panic(uint256), raised by failed assertions and checked arithmetic
+var panicSelector = [4]byte{0x4e, 0x48, 0x7b, 0x71}
+
+// DecodeRevert decodes the data of a revert: the reason of Error(string), the code of Panic(uint256),
+// or a custom error of the registered ABIs, rendered as Contract.Error(name: value, ...)
+func (r *Registry) DecodeRevert(data []byte) (string, error) {
+	if len(data) == 0 {
+		return "reverted without a reason", nil
+	}
+	if len(data) < 4 {
+		return "", fmt.Errorf("revert data 0x%s is shorter than an error selector", hex.EncodeToString(data))
+	}
+
+	var selector [4]byte
+	copy(selector[:], data[:4])
+
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		if selector == panicSelector {
+			return "panic: " + reason, nil
+		}
+		return reason, nil
+	}
+
+	entries, ok := r.errors[selector]
+	if !ok {
+		return "", fmt.Errorf("unknown error selector 0x%s, revert data 0x%s", hex.EncodeToString(selector[:]), hex.EncodeToString(data))
+	}
+
+	var lastErr error
+	for _, entry := range entries {
+		values, err := entry.abiError.Inputs.Unpack(data[4:])
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		args := make([]string, len(values))
+		for i, input := range entry.abiError.Inputs {
+			args[i] = FormatValue(values[i])
+			if input.Name != "" {
+				args[i] = input.Name + ": " + args[i]
+			}
+		}
+		return strings.Join(entry.contracts, "|") + "." + entry.abiError.Name + "(" + strings.Join(args, ", ") + ")", nil
+	}
+
+	return "", fmt.Errorf("failed to decode arguments of error selector 0x%s: %v", hex.EncodeToString(selector[:]), lastErr)
+}
+
+// FormatValue renders a decoded ABI value for humans. Left-padded bytes32 values that hold an
+// address (as CCTP mint recipients do) are shown with the address next to them.
+func FormatValue(value interface{}) string {
+	switch v := value.(type) {
+	case common.Address:
+		return v.Hex()
+	case *big.Int:
+		return v.String()
+	case []byte:
+		return "0x" + hex.EncodeToString(v)
+	case [32]byte:
+		formatted := "0x" + hex.EncodeToString(v[:])
+		if isPaddedAddress(v) {
+			formatted += " (address " + common.BytesToAddress(v[12:]).Hex() + ")"
+		}
+		return formatted
+	case string:
+		return fmt.Sprintf("%q", v)
+	}
+
+	reflected := reflect.ValueOf(value)
+	switch reflected.Kind() {
+	case reflect.Struct:
+		fields := make([]string, reflected.NumField())
+		for i := 0; i < reflected.NumField(); i++ {
+			fields[i] = reflected.Type().Field(i).Name + ": " + FormatValue(reflected.Field(i).Interface())
+		}
+		return "{" + strings.Join(fields, ", ") + "}"
+	case reflect.Slice, reflect.Array:
+		items := make([]string, reflected.Len())
+		for i := 0; i < reflected.Len(); i++ {
+			items[i] = FormatValue(reflected.Index(i).Interface())
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	}
+
+	return fmt.Sprintf("%v", value)
+}
+
+func isPaddedAddress(value [32]byte) bool {
+	for _, b := range value[:12] {
+		if b != 0 {
+			return false
+		}
+	}
+	return common.BytesToAddress(value[12:]) != (common.Address{})
+}
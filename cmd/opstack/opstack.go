@@ -0,0 +1,177 @@
+package opstack
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/G7DAO/bifrost/bindings/L1StandardBridge"
+	"github.com/G7DAO/bifrost/bindings/OptimismMintableERC20Factory"
+	"github.com/G7DAO/bifrost/cmd/base"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/cobra"
+)
+
+// registryFlag is a flag of an opstack subcommand that defaults to a contract of the --chain
+type registryFlag struct {
+	// command is the path of the subcommand under opstack, it also matches its own subcommands
+	command  string
+	flag     string
+	contract string
+}
+
+var registryFlags = []registryFlag{
+	{command: "bridge eth deposit", flag: "bridge", contract: ContractL1StandardBridge},
+	{command: "bridge erc20 deposit", flag: "bridge", contract: ContractL1StandardBridge},
+	{command: "deposit status", flag: "portal", contract: ContractOptimismPortal},
+	{command: "message", flag: "messenger", contract: ContractL1CrossDomainMessenger},
+	{command: "withdraw prove", flag: "portal", contract: ContractOptimismPortal},
+	{command: "withdraw finalize", flag: "portal", contract: ContractOptimismPortal},
+	{command: "withdraw status", flag: "portal", contract: ContractOptimismPortal},
+	{command: "l-1-standard-bridge", flag: "contract", contract: ContractL1StandardBridge},
+}
+
+func CreateOpStackCommand() *cobra.Command {
+	var chainName, registryPath string
+
+	opstackCmd := &cobra.Command{
+		Use:   "opstack",
+		Short: "OP Stack commands",
+		Long: `OP Stack commands, for Base, OP Mainnet and any other OP Stack chain
+
+With --chain, the L1 contract flags of the subcommands default to the contracts of the chain in the
+registry, and the chain IDs of --l1-rpc and --l2-rpc are checked against it. The registry bundles
+well known chains, --registry adds the chains of a JSON file to it.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if chainName == "" {
+				return nil
+			}
+
+			registry, err := LoadRegistry(registryPath)
+			if err != nil {
+				return err
+			}
+
+			chain, err := registry.Lookup(chainName)
+			if err != nil {
+				return err
+			}
+
+			return applyChain(cmd, chain)
+		},
+	}
+
+	opstackCmd.PersistentFlags().StringVar(&chainName, "chain", "", "OP Stack chain of the registry, by name or chain ID (optional)")
+	opstackCmd.PersistentFlags().StringVar(&registryPath, "registry", "", "JSON file of OP Stack chains added to the bundled registry (optional)")
+
+	l1StandardBridgeCmd := L1StandardBridge.CreateL1StandardBridgeCommand()
+	optimismMintableERC20FactoryCmd := OptimismMintableERC20Factory.CreateOptimismMintableERC20FactoryCommand()
+
+	opstackCmd.AddCommand(l1StandardBridgeCmd, optimismMintableERC20FactoryCmd)
+	opstackCmd.AddCommand(base.CreateBaseBridgeCommand())
+	opstackCmd.AddCommand(base.CreateDepositCommand())
+	opstackCmd.AddCommand(base.CreateMessageCommand())
+	opstackCmd.AddCommand(base.CreateTokenCommand())
+	opstackCmd.AddCommand(base.CreateWithdrawCommand())
+	opstackCmd.AddCommand(CreateChainsCommand(&registryPath))
+
+	return opstackCmd
+}
+
+// applyChain sets the registry flags of the command that were not set explicitly to the contracts of
+// the chain, and checks that the RPCs of the command point to the chain and its L1
+func applyChain(cmd *cobra.Command, chain *Chain) error {
+	path := commandPath(cmd)
+	for _, registryFlag := range registryFlags {
+		if path != registryFlag.command && !strings.HasPrefix(path, registryFlag.command+" ") {
+			continue
+		}
+
+		flag := cmd.Flags().Lookup(registryFlag.flag)
+		if flag == nil || flag.Changed {
+			continue
+		}
+
+		address, err := chain.Address(registryFlag.contract)
+		if err != nil {
+			return err
+		}
+		if err := cmd.Flags().Set(registryFlag.flag, address.Hex()); err != nil {
+			return err
+		}
+		fmt.Println("Using", registryFlag.contract, "of", chain.Name, "(", address.Hex(), ") as --"+registryFlag.flag)
+	}
+
+	rpcFlags := []struct {
+		flag    string
+		chainID uint64
+	}{
+		{flag: "l1-rpc", chainID: chain.L1ChainID},
+		{flag: "l2-rpc", chainID: chain.ChainID},
+	}
+	for _, rpcFlag := range rpcFlags {
+		flag := cmd.Flags().Lookup(rpcFlag.flag)
+		if flag == nil || flag.Value.String() == "" {
+			continue
+		}
+
+		if err := checkChainID(flag.Value.String(), rpcFlag.chainID); err != nil {
+			return fmt.Errorf("--%s does not match --chain %s: %v", rpcFlag.flag, chain.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// commandPath returns the path of the command under opstack
+func commandPath(cmd *cobra.Command) string {
+	var names []string
+	for c := cmd; c != nil && c.Name() != "opstack"; c = c.Parent() {
+		names = append([]string{c.Name()}, names...)
+	}
+	return strings.Join(names, " ")
+}
+
+func checkChainID(rpc string, expected uint64) error {
+	client, err := ethclient.Dial(rpc)
+	if err != nil {
+		return err
+	}
+
+	chainID, err := client.ChainID(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to fetch chain ID: %v", err)
+	}
+	if chainID.Uint64() != expected {
+		return fmt.Errorf("expected chain ID %d, got %s", expected, chainID.String())
+	}
+	return nil
+}
+
+func CreateChainsCommand(registryPath *string) *cobra.Command {
+	chainsCmd := &cobra.Command{
+		Use:   "chains",
+		Short: "List the OP Stack chains of the registry",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			registry, err := LoadRegistry(*registryPath)
+			if err != nil {
+				return err
+			}
+
+			writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(writer, "NAME\tCHAIN ID\tL1 CHAIN ID\tL1 STANDARD BRIDGE\tOPTIMISM PORTAL")
+			for _, chain := range registry.Chains {
+				fmt.Fprintf(writer, "%s\t%d\t%d\t%s\t%s\n", chain.Name, chain.ChainID, chain.L1ChainID, chain.Addresses.L1StandardBridge.Hex(), chain.Addresses.OptimismPortal.Hex())
+			}
+			return writer.Flush()
+		},
+	}
+
+	return chainsCmd
+}
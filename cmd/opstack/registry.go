@@ -0,0 +1,167 @@
+package opstack
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// bundledRegistry lists the L1 contracts of well known OP Stack chains
+// Source: https://github.com/ethereum-optimism/superchain-registry
+//
+//go:embed chains.json
+var bundledRegistry []byte
+
+// Names of the L1 contracts of a chain, as used in the registry files
+const (
+	ContractL1StandardBridge       = "L1StandardBridge"
+	ContractOptimismPortal         = "OptimismPortal"
+	ContractL1CrossDomainMessenger = "L1CrossDomainMessenger"
+	ContractSystemConfig           = "SystemConfig"
+	ContractDisputeGameFactory     = "DisputeGameFactory"
+)
+
+// Addresses are the L1 contracts of an OP Stack chain
+type Addresses struct {
+	L1StandardBridge       common.Address `json:"L1StandardBridge"`
+	OptimismPortal         common.Address `json:"OptimismPortal"`
+	L1CrossDomainMessenger common.Address `json:"L1CrossDomainMessenger"`
+	SystemConfig           common.Address `json:"SystemConfig"`
+	DisputeGameFactory     common.Address `json:"DisputeGameFactory"`
+}
+
+// Get returns the address of a contract by name, or the zero address if it is not known
+func (a *Addresses) Get(contract string) common.Address {
+	switch contract {
+	case ContractL1StandardBridge:
+		return a.L1StandardBridge
+	case ContractOptimismPortal:
+		return a.OptimismPortal
+	case ContractL1CrossDomainMessenger:
+		return a.L1CrossDomainMessenger
+	case ContractSystemConfig:
+		return a.SystemConfig
+	case ContractDisputeGameFactory:
+		return a.DisputeGameFactory
+	}
+	return common.Address{}
+}
+
+// Chain is an OP Stack chain and the L1 it settles on
+type Chain struct {
+	Name      string    `json:"name"`
+	Aliases   []string  `json:"aliases,omitempty"`
+	ChainID   uint64    `json:"chainId"`
+	L1ChainID uint64    `json:"l1ChainId"`
+	Addresses Addresses `json:"addresses"`
+}
+
+// Registry is a set of OP Stack chains
+type Registry struct {
+	Chains []Chain
+}
+
+func parseChains(data []byte) ([]Chain, error) {
+	var chains []Chain
+	if err := json.Unmarshal(data, &chains); err != nil {
+		return nil, err
+	}
+
+	for i := range chains {
+		if chains[i].Name == "" {
+			return nil, fmt.Errorf("chain %d has no name", i)
+		}
+		if chains[i].ChainID == 0 || chains[i].L1ChainID == 0 {
+			return nil, fmt.Errorf("chain %s needs chainId and l1ChainId", chains[i].Name)
+		}
+	}
+	return chains, nil
+}
+
+// LoadRegistry loads the bundled registry. If path is set, the chains of the file at path are added
+// to it, replacing bundled chains of the same name.
+func LoadRegistry(path string) (*Registry, error) {
+	chains, err := parseChains(bundledRegistry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse bundled registry: %v", err)
+	}
+	registry := &Registry{Chains: chains}
+
+	if path == "" {
+		return registry, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read registry %s: %v", path, err)
+	}
+	userChains, err := parseChains(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse registry %s: %v", path, err)
+	}
+
+	for _, userChain := range userChains {
+		replaced := false
+		for i := range registry.Chains {
+			if strings.EqualFold(registry.Chains[i].Name, userChain.Name) {
+				registry.Chains[i] = userChain
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			registry.Chains = append(registry.Chains, userChain)
+		}
+	}
+
+	return registry, nil
+}
+
+// Lookup returns the chain matching a chain ID, a name or an alias, case insensitively
+func (r *Registry) Lookup(nameOrID string) (*Chain, error) {
+	if chainID, err := strconv.ParseUint(nameOrID, 10, 64); err == nil {
+		for i := range r.Chains {
+			if r.Chains[i].ChainID == chainID {
+				return &r.Chains[i], nil
+			}
+		}
+		return nil, fmt.Errorf("unknown OP Stack chain %d", chainID)
+	}
+
+	normalized := strings.ToLower(strings.TrimSpace(nameOrID))
+	for i := range r.Chains {
+		if strings.ToLower(r.Chains[i].Name) == normalized {
+			return &r.Chains[i], nil
+		}
+		for _, alias := range r.Chains[i].Aliases {
+			if strings.ToLower(alias) == normalized {
+				return &r.Chains[i], nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("unknown OP Stack chain %q, expected one of %s", nameOrID, strings.Join(r.Names(), ", "))
+}
+
+// Names returns the names of the chains of the registry
+func (r *Registry) Names() []string {
+	names := make([]string, len(r.Chains))
+	for i := range r.Chains {
+		names[i] = r.Chains[i].Name
+	}
+	return names
+}
+
+// Address returns the address of a contract of the chain
+func (c *Chain) Address(contract string) (common.Address, error) {
+	address := c.Addresses.Get(contract)
+	if address == (common.Address{}) {
+		return common.Address{}, fmt.Errorf("%s of %s is not in the registry", contract, c.Name)
+	}
+	return address, nil
+}
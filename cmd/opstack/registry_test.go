@@ -0,0 +1,169 @@
+package opstack
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+func TestLoadBundledRegistry(t *testing.T) {
+	registry, err := LoadRegistry("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, chain := range registry.Chains {
+		for _, contract := range []string{ContractL1StandardBridge, ContractOptimismPortal, ContractL1CrossDomainMessenger, ContractSystemConfig, ContractDisputeGameFactory} {
+			if _, err := chain.Address(contract); err != nil {
+				t.Error(err)
+			}
+		}
+	}
+
+	for _, nameOrID := range []string{"base-sepolia", "Base-Sepolia", "84532"} {
+		chain, err := registry.Lookup(nameOrID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if chain.Name != "base-sepolia" || chain.L1ChainID != 11155111 {
+			t.Errorf("unexpected chain %+v for %s", chain, nameOrID)
+		}
+	}
+
+	chain, err := registry.Lookup("optimism")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if chain.ChainID != 10 {
+		t.Errorf("expected the optimism alias to resolve to chain 10, got %d", chain.ChainID)
+	}
+
+	if _, err := registry.Lookup("unknown"); err == nil {
+		t.Error("expected an error on an unknown chain")
+	}
+}
+
+func TestBundledRegistryChains(t *testing.T) {
+	registry, err := LoadRegistry("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string][2]uint64{
+		"base":         {8453, 1},
+		"base-sepolia": {84532, 11155111},
+		"op":           {10, 1},
+		"op-sepolia":   {11155420, 11155111},
+		"zora":         {7777777, 1},
+		"mode":         {34443, 1},
+	}
+	if len(registry.Chains) != len(expected) {
+		t.Errorf("expected %d bundled chains, got %v", len(expected), registry.Names())
+	}
+
+	for name, chainIDs := range expected {
+		chain, err := registry.Lookup(name)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if chain.ChainID != chainIDs[0] || chain.L1ChainID != chainIDs[1] {
+			t.Errorf("%s: expected chain %d on L1 %d, got chain %d on L1 %d", name, chainIDs[0], chainIDs[1], chain.ChainID, chain.L1ChainID)
+		}
+
+		seen := map[common.Address]string{}
+		for _, contract := range []string{ContractL1StandardBridge, ContractOptimismPortal, ContractL1CrossDomainMessenger, ContractSystemConfig, ContractDisputeGameFactory} {
+			address, err := chain.Address(contract)
+			if err != nil {
+				t.Error(err)
+				continue
+			}
+			if other, ok := seen[address]; ok {
+				t.Errorf("%s: %s and %s share the address %s", name, other, contract, address.Hex())
+			}
+			seen[address] = contract
+		}
+	}
+}
+
+func TestLoadUserRegistry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "chains.json")
+	userRegistry := `[
+  {"name": "base", "chainId": 8453, "l1ChainId": 1, "addresses": {"OptimismPortal": "0x0000000000000000000000000000000000000001"}},
+  {"name": "devnet", "chainId": 901, "l1ChainId": 900, "addresses": {"L1StandardBridge": "0x0000000000000000000000000000000000000002"}}
+]`
+	if err := os.WriteFile(path, []byte(userRegistry), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	registry, err := LoadRegistry(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	base, err := registry.Lookup("base")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if base.Addresses.OptimismPortal != common.HexToAddress("0x01") {
+		t.Errorf("expected the user registry to replace base, got portal %s", base.Addresses.OptimismPortal.Hex())
+	}
+	if _, err := base.Address(ContractL1StandardBridge); err == nil {
+		t.Error("expected an error on a contract missing from the registry")
+	}
+
+	devnet, err := registry.Lookup("901")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if devnet.Addresses.L1StandardBridge != common.HexToAddress("0x02") {
+		t.Errorf("unexpected devnet bridge %s", devnet.Addresses.L1StandardBridge.Hex())
+	}
+
+	if err := os.WriteFile(path, []byte(`[{"name": "devnet"}]`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadRegistry(path); err == nil {
+		t.Error("expected an error on a chain without chain IDs")
+	}
+}
+
+func TestApplyChain(t *testing.T) {
+	registry, err := LoadRegistry("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain, err := registry.Lookup("base-sepolia")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var portal string
+	opstackCmd := &cobra.Command{Use: "opstack"}
+	withdrawCmd := &cobra.Command{Use: "withdraw"}
+	proveCmd := &cobra.Command{Use: "prove"}
+	proveCmd.Flags().StringVar(&portal, "portal", "", "")
+	opstackCmd.AddCommand(withdrawCmd)
+	withdrawCmd.AddCommand(proveCmd)
+
+	if err := applyChain(proveCmd, chain); err != nil {
+		t.Fatal(err)
+	}
+	if portal != chain.Addresses.OptimismPortal.Hex() {
+		t.Errorf("expected --portal to default to %s, got %s", chain.Addresses.OptimismPortal.Hex(), portal)
+	}
+
+	explicit := "0x0000000000000000000000000000000000000003"
+	if err := proveCmd.Flags().Set("portal", explicit); err != nil {
+		t.Fatal(err)
+	}
+	if err := applyChain(proveCmd, chain); err != nil {
+		t.Fatal(err)
+	}
+	if portal != explicit {
+		t.Errorf("expected an explicit --portal to be kept, got %s", portal)
+	}
+}
@@ -0,0 +1,363 @@
+package safe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"regexp"
+
+	"github.com/G7DAO/bifrost/cmd/safe/txservice"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/cobra"
+)
+
+func CreateSafeCommand() *cobra.Command {
+	safeCmd := &cobra.Command{
+		Use:   "safe",
+		Short: "Manage Safe owners and policies through Safe proposals",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	safeCmd.AddCommand(CreateOwnersCommand())
+	safeCmd.AddCommand(CreateThresholdCommand())
+	safeCmd.AddCommand(CreateReviewCommand())
+
+	return safeCmd
+}
+
+func CreateOwnersCommand() *cobra.Command {
+	ownersCmd := &cobra.Command{
+		Use:   "owners",
+		Short: "Propose changes to the owners of a Safe",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	ownersCmd.AddCommand(CreateOwnersAddCommand())
+	ownersCmd.AddCommand(CreateOwnersRemoveCommand())
+	ownersCmd.AddCommand(CreateOwnersSwapCommand())
+
+	return ownersCmd
+}
+
+func CreateThresholdCommand() *cobra.Command {
+	thresholdCmd := &cobra.Command{
+		Use:   "threshold",
+		Short: "Propose changes to the threshold of a Safe",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	thresholdCmd.AddCommand(CreateThresholdSetCommand())
+
+	return thresholdCmd
+}
+
+// parseSelfCallFlags validates the flags shared by all owner and threshold proposals. The Safe is
+// both the proposer and the target, so --safe is required and only Call operations make sense.
+func parseSelfCallFlags(keyFile string, rpc string, safeFlags *Flags) error {
+	if keyFile == "" {
+		return errors.New("keyfile is required")
+	}
+
+	if rpc == "" {
+		return errors.New("rpc is required")
+	}
+
+	if !safeFlags.IsSet() {
+		return errors.New("--safe is required")
+	}
+
+	if safeErr := safeFlags.Parse(rpc); safeErr != nil {
+		return safeErr
+	}
+
+	if OperationType(safeFlags.Operation) != Call {
+		return errors.New("--safe-operation must be 0 (Call) for owner and threshold changes")
+	}
+
+	return nil
+}
+
+func parseThreshold(thresholdRaw string) (*big.Int, error) {
+	if thresholdRaw == "" {
+		return nil, nil
+	}
+
+	threshold, ok := new(big.Int).SetString(thresholdRaw, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid threshold: %s", thresholdRaw)
+	}
+	return threshold, nil
+}
+
+func CreateOwnersAddCommand() *cobra.Command {
+	var keyFile, password, rpc, ownerRaw, thresholdRaw string
+	var owner common.Address
+	var threshold *big.Int
+	safeFlags := &Flags{}
+
+	addCmd := &cobra.Command{
+		Use:   "add",
+		Short: "Propose adding an owner to a Safe",
+		Long:  `Propose adding an owner to a Safe with addOwnerWithThreshold. The current threshold is kept unless --threshold is given.`,
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if !common.IsHexAddress(ownerRaw) {
+				return errors.New("invalid owner address")
+			}
+			owner = common.HexToAddress(ownerRaw)
+
+			var thresholdErr error
+			threshold, thresholdErr = parseThreshold(thresholdRaw)
+			if thresholdErr != nil {
+				return thresholdErr
+			}
+
+			return parseSelfCallFlags(keyFile, rpc, safeFlags)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fmt.Println("Proposing to add", owner.Hex(), "as owner of", safeFlags.Address.Hex())
+			err := ProposeSafeSelfCall(rpc, keyFile, password, safeFlags, func(owners []common.Address, currentThreshold *big.Int) ([]byte, error) {
+				return GetAddOwnerCalldata(owners, currentThreshold, owner, threshold)
+			})
+			if err != nil {
+				fmt.Fprintln(cmd.ErrOrStderr(), err.Error())
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	addCmd.Flags().StringVar(&password, "password", "", "Password to decrypt keyfile with")
+	addCmd.Flags().StringVar(&keyFile, "keyfile", "", "Keyfile of a Safe owner to sign the proposal with")
+	addCmd.Flags().StringVar(&rpc, "rpc", "", "RPC URL")
+	addCmd.Flags().StringVar(&ownerRaw, "owner", "", "Address of the owner to add")
+	addCmd.Flags().StringVar(&thresholdRaw, "threshold", "", "New threshold (optional, defaults to the current threshold)")
+	safeFlags.AddFlags(addCmd)
+
+	return addCmd
+}
+
+func CreateOwnersRemoveCommand() *cobra.Command {
+	var keyFile, password, rpc, ownerRaw, thresholdRaw string
+	var owner common.Address
+	var threshold *big.Int
+	safeFlags := &Flags{}
+
+	removeCmd := &cobra.Command{
+		Use:   "remove",
+		Short: "Propose removing an owner from a Safe",
+		Long:  `Propose removing an owner from a Safe with removeOwner. The prevOwner argument is looked up from getOwners, and the current threshold is kept (lowered if it would exceed the remaining owners) unless --threshold is given.`,
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if !common.IsHexAddress(ownerRaw) {
+				return errors.New("invalid owner address")
+			}
+			owner = common.HexToAddress(ownerRaw)
+
+			var thresholdErr error
+			threshold, thresholdErr = parseThreshold(thresholdRaw)
+			if thresholdErr != nil {
+				return thresholdErr
+			}
+
+			return parseSelfCallFlags(keyFile, rpc, safeFlags)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fmt.Println("Proposing to remove", owner.Hex(), "as owner of", safeFlags.Address.Hex())
+			err := ProposeSafeSelfCall(rpc, keyFile, password, safeFlags, func(owners []common.Address, currentThreshold *big.Int) ([]byte, error) {
+				return GetRemoveOwnerCalldata(owners, currentThreshold, owner, threshold)
+			})
+			if err != nil {
+				fmt.Fprintln(cmd.ErrOrStderr(), err.Error())
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	removeCmd.Flags().StringVar(&password, "password", "", "Password to decrypt keyfile with")
+	removeCmd.Flags().StringVar(&keyFile, "keyfile", "", "Keyfile of a Safe owner to sign the proposal with")
+	removeCmd.Flags().StringVar(&rpc, "rpc", "", "RPC URL")
+	removeCmd.Flags().StringVar(&ownerRaw, "owner", "", "Address of the owner to remove")
+	removeCmd.Flags().StringVar(&thresholdRaw, "threshold", "", "New threshold (optional, defaults to the current threshold)")
+	safeFlags.AddFlags(removeCmd)
+
+	return removeCmd
+}
+
+func CreateOwnersSwapCommand() *cobra.Command {
+	var keyFile, password, rpc, oldOwnerRaw, newOwnerRaw string
+	var oldOwner, newOwner common.Address
+	safeFlags := &Flags{}
+
+	swapCmd := &cobra.Command{
+		Use:   "swap",
+		Short: "Propose replacing an owner of a Safe",
+		Long:  `Propose replacing an owner of a Safe with swapOwner. The prevOwner argument is looked up from getOwners.`,
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if !common.IsHexAddress(oldOwnerRaw) {
+				return errors.New("invalid old owner address")
+			}
+			oldOwner = common.HexToAddress(oldOwnerRaw)
+
+			if !common.IsHexAddress(newOwnerRaw) {
+				return errors.New("invalid new owner address")
+			}
+			newOwner = common.HexToAddress(newOwnerRaw)
+
+			return parseSelfCallFlags(keyFile, rpc, safeFlags)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fmt.Println("Proposing to replace", oldOwner.Hex(), "with", newOwner.Hex(), "as owner of", safeFlags.Address.Hex())
+			err := ProposeSafeSelfCall(rpc, keyFile, password, safeFlags, func(owners []common.Address, currentThreshold *big.Int) ([]byte, error) {
+				return GetSwapOwnerCalldata(owners, oldOwner, newOwner)
+			})
+			if err != nil {
+				fmt.Fprintln(cmd.ErrOrStderr(), err.Error())
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	swapCmd.Flags().StringVar(&password, "password", "", "Password to decrypt keyfile with")
+	swapCmd.Flags().StringVar(&keyFile, "keyfile", "", "Keyfile of a Safe owner to sign the proposal with")
+	swapCmd.Flags().StringVar(&rpc, "rpc", "", "RPC URL")
+	swapCmd.Flags().StringVar(&oldOwnerRaw, "old-owner", "", "Address of the owner to replace")
+	swapCmd.Flags().StringVar(&newOwnerRaw, "new-owner", "", "Address of the new owner")
+	safeFlags.AddFlags(swapCmd)
+
+	return swapCmd
+}
+
+func CreateThresholdSetCommand() *cobra.Command {
+	var keyFile, password, rpc, thresholdRaw string
+	var threshold *big.Int
+	safeFlags := &Flags{}
+
+	setCmd := &cobra.Command{
+		Use:   "set",
+		Short: "Propose changing the threshold of a Safe",
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if thresholdRaw == "" {
+				return errors.New("threshold is required")
+			}
+
+			var thresholdErr error
+			threshold, thresholdErr = parseThreshold(thresholdRaw)
+			if thresholdErr != nil {
+				return thresholdErr
+			}
+
+			return parseSelfCallFlags(keyFile, rpc, safeFlags)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fmt.Println("Proposing to set the threshold of", safeFlags.Address.Hex(), "to", threshold.String())
+			err := ProposeSafeSelfCall(rpc, keyFile, password, safeFlags, func(owners []common.Address, currentThreshold *big.Int) ([]byte, error) {
+				return GetChangeThresholdCalldata(owners, threshold)
+			})
+			if err != nil {
+				fmt.Fprintln(cmd.ErrOrStderr(), err.Error())
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	setCmd.Flags().StringVar(&password, "password", "", "Password to decrypt keyfile with")
+	setCmd.Flags().StringVar(&keyFile, "keyfile", "", "Keyfile of a Safe owner to sign the proposal with")
+	setCmd.Flags().StringVar(&rpc, "rpc", "", "RPC URL")
+	setCmd.Flags().StringVar(&thresholdRaw, "threshold", "", "New threshold")
+	safeFlags.AddFlags(setCmd)
+
+	return setCmd
+}
+
+var safeTxHashRegexp = regexp.MustCompile("^0x[0-9a-fA-F]{64}$")
+
+func CreateReviewCommand() *cobra.Command {
+	var safeApi, rpc string
+
+	reviewCmd := &cobra.Command{
+		Use:   "review <safeTxHash|file>",
+		Short: "Decode a Safe proposal for review before signing",
+		Long: `Decode a Safe proposal for review before signing.
+
+The proposal is either fetched by its safeTxHash from the Safe Transaction Service, or read from a JSON file with the
+to, value, data and operation fields of the Safe transaction. The call is decoded against every ABI shipped with
+bifrost, and calls embedded in MultiSend batches, retryable tickets and teleports are decoded recursively.`,
+		Args: cobra.ExactArgs(1),
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if _, statErr := os.Stat(args[0]); statErr == nil {
+				return nil
+			}
+
+			if !safeTxHashRegexp.MatchString(args[0]) {
+				return fmt.Errorf("%s is neither a file nor a safeTxHash", args[0])
+			}
+
+			if safeApi == "" {
+				if rpc == "" {
+					return errors.New("--safe-api or --rpc is required to fetch a proposal by safeTxHash")
+				}
+
+				client, clientErr := ethclient.DialContext(context.Background(), rpc)
+				if clientErr != nil {
+					return clientErr
+				}
+
+				chainID, chainIDErr := client.ChainID(context.Background())
+				if chainIDErr != nil {
+					return chainIDErr
+				}
+
+				var safeApiErr error
+				safeApi, safeApiErr = txservice.DefaultURL(chainID)
+				if safeApiErr != nil {
+					return safeApiErr
+				}
+				return nil
+			}
+
+			var safeApiErr error
+			safeApi, safeApiErr = txservice.ServiceURL(safeApi)
+			return safeApiErr
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var transaction ReviewTransaction
+			var transactionErr error
+			if safeTxHashRegexp.MatchString(args[0]) {
+				transaction, transactionErr = FetchReviewTransaction(safeApi, common.HexToHash(args[0]))
+			} else {
+				transaction, transactionErr = LoadReviewTransaction(args[0])
+			}
+			if transactionErr != nil {
+				fmt.Fprintln(cmd.ErrOrStderr(), transactionErr.Error())
+				return transactionErr
+			}
+
+			return Review(cmd.OutOrStdout(), transaction)
+		},
+	}
+
+	reviewCmd.Flags().StringVar(&safeApi, "safe-api", "", "Base URL of the Safe Transaction Service, e.g. https://safe-transaction-mainnet.safe.global (optional, defaults to the service hosted by Safe for the chain of --rpc)")
+	reviewCmd.Flags().StringVar(&rpc, "rpc", "", "RPC URL, used to pick the default Safe Transaction Service")
+
+	return reviewCmd
+}
@@ -0,0 +1,160 @@
+package safe
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/G7DAO/bifrost/bindings/GnosisSafe"
+	"github.com/G7DAO/bifrost/cmd/safe/txservice"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/cobra"
+)
+
+// Flags holds the --safe* flags shared by every command that can create a Safe proposal instead of
+// sending a transaction from the keyfile account
+type Flags struct {
+	AddressRaw string
+	Api        string
+	Operation  uint8
+	NonceRaw   string
+	ReplaceRaw string
+
+	Address common.Address
+	Nonce   *big.Int
+	Replace bool
+}
+
+// AddFlags registers the --safe, --safe-api, --safe-operation, --safe-nonce and --safe-replace flags
+// on the command
+func (f *Flags) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.AddressRaw, "safe", "", "Address of the Safe contract")
+	cmd.Flags().StringVar(&f.Api, "safe-api", "", "Base URL of the Safe Transaction Service, e.g. https://safe-transaction-mainnet.safe.global (optional, defaults to the service hosted by Safe for the chain). Safe-client propose URLs, taken by earlier versions, are translated to the service of their chain")
+	cmd.Flags().Uint8Var(&f.Operation, "safe-operation", 0, "Safe operation type: 0 (Call) or 1 (DelegateCall)")
+	cmd.Flags().StringVar(&f.NonceRaw, "safe-nonce", "", "Safe nonce (optional, defaults to the next nonce after the proposals queued in the Safe Transaction Service)")
+	cmd.Flags().StringVar(&f.ReplaceRaw, "safe-replace", "", "Nonce of a queued Safe proposal to replace with this one")
+}
+
+// IsSet returns true if the transaction should be proposed to a Safe
+func (f *Flags) IsSet() bool {
+	return f.AddressRaw != ""
+}
+
+// Parse validates the Safe flags. If --safe-api is not set, the default API is derived from the
+// chain ID returned by the given RPC, which is only dialed when needed. An explicit --safe-nonce must
+// not be taken by a queued proposal, while --safe-replace must be.
+func (f *Flags) Parse(rpc string) error {
+	if !f.IsSet() {
+		return nil
+	}
+
+	if !common.IsHexAddress(f.AddressRaw) {
+		return fmt.Errorf("--safe is not a valid Ethereum address")
+	}
+	f.Address = common.HexToAddress(f.AddressRaw)
+
+	if OperationType(f.Operation).String() == "Unknown" {
+		return fmt.Errorf("--safe-operation must be 0 (Call) or 1 (DelegateCall)")
+	}
+
+	if f.NonceRaw != "" && f.ReplaceRaw != "" {
+		return fmt.Errorf("--safe-nonce and --safe-replace cannot be used together")
+	}
+
+	if f.Api != "" {
+		serviceURL, serviceErr := txservice.ServiceURL(f.Api)
+		if serviceErr != nil {
+			return serviceErr
+		}
+		if serviceURL != f.Api {
+			fmt.Println("--safe-api", f.Api, "is a safe-client propose URL, using the Safe Transaction Service of its chain (", serviceURL, ")")
+		}
+		f.Api = serviceURL
+	}
+
+	if f.Api == "" || f.NonceRaw != "" || f.ReplaceRaw != "" {
+		client, clientErr := ethclient.DialContext(context.Background(), rpc)
+		if clientErr != nil {
+			return clientErr
+		}
+		return f.parseWithClient(client)
+	}
+
+	fmt.Println("--safe-nonce not specified, using the next nonce after the queued proposals")
+	return nil
+}
+
+// parseWithClient derives the default --safe-api from the chain ID of the client, and checks an
+// explicit nonce against the Safe
+func (f *Flags) parseWithClient(client *ethclient.Client) error {
+	if f.Api == "" {
+		chainID, chainIDErr := client.ChainID(context.Background())
+		if chainIDErr != nil {
+			return chainIDErr
+		}
+		defaultApi, defaultApiErr := txservice.DefaultURL(chainID)
+		if defaultApiErr != nil {
+			return defaultApiErr
+		}
+		f.Api = defaultApi
+		fmt.Println("--safe-api not specified, using default (", f.Api, ")")
+	}
+
+	if f.NonceRaw == "" && f.ReplaceRaw == "" {
+		fmt.Println("--safe-nonce not specified, using the next nonce after the queued proposals")
+		return nil
+	}
+
+	nonceRaw, nonceFlag := f.NonceRaw, "--safe-nonce"
+	if f.ReplaceRaw != "" {
+		nonceRaw, nonceFlag = f.ReplaceRaw, "--safe-replace"
+		f.Replace = true
+	}
+
+	f.Nonce = new(big.Int)
+	_, ok := f.Nonce.SetString(nonceRaw, 0)
+	if !ok || f.Nonce.Sign() < 0 {
+		return fmt.Errorf("%s is not a valid nonce", nonceFlag)
+	}
+
+	return f.checkNonce(client)
+}
+
+// checkNonce makes sure the nonce was not executed yet, and that it is taken by a queued proposal
+// only when replacing
+func (f *Flags) checkNonce(client *ethclient.Client) error {
+	safeInstance, safeErr := GnosisSafe.NewGnosisSafe(f.Address, client)
+	if safeErr != nil {
+		return safeErr
+	}
+
+	onChainNonce, nonceErr := safeInstance.Nonce(&bind.CallOpts{})
+	if nonceErr != nil {
+		return fmt.Errorf("failed to fetch nonce from Safe contract: %v", nonceErr)
+	}
+	if f.Nonce.Cmp(onChainNonce) < 0 {
+		return fmt.Errorf("nonce %s was already executed, the current Safe nonce is %s", f.Nonce.String(), onChainNonce.String())
+	}
+
+	queued, queuedErr := QueuedTransactions(context.Background(), txservice.NewClient(f.Api), f.Address, f.Nonce)
+	if queuedErr != nil {
+		return fmt.Errorf("failed to fetch queued proposals from Safe Transaction Service: %v", queuedErr)
+	}
+
+	if !f.Replace {
+		if len(queued) > 0 {
+			return fmt.Errorf("nonce %s is already used by queued proposal %s, use --safe-replace %s to replace it", f.Nonce.String(), queued[0].SafeTxHash, f.Nonce.String())
+		}
+		return nil
+	}
+
+	if len(queued) == 0 {
+		return fmt.Errorf("no proposal is queued with nonce %s, use --safe-nonce to propose with a new nonce", f.Nonce.String())
+	}
+	for _, transaction := range queued {
+		fmt.Println("Replacing queued proposal", transaction.SafeTxHash, "with nonce", f.Nonce.String())
+	}
+	return nil
+}
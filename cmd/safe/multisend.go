@@ -0,0 +1,86 @@
+package safe
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Source: https://github.com/safe-global/safe-deployments/blob/main/src/assets/v1.3.0/multi_send_call_only.json
+const MultiSendCallOnlyAddress = "0x40A2aCCbd92BCA938b02010E17A5b8929b49130D"
+
+// Source: https://github.com/safe-global/safe-smart-account/blob/v1.3.0/contracts/libraries/MultiSendCallOnly.sol
+const MultiSendABI = `[{"inputs":[{"internalType":"bytes","name":"transactions","type":"bytes"}],"name":"multiSend","outputs":[],"stateMutability":"payable","type":"function"}]`
+
+// MultiSendTransaction is a single call inside a MultiSend batch
+type MultiSendTransaction struct {
+	Operation OperationType
+	To        common.Address
+	Value     *big.Int
+	Data      []byte
+}
+
+// PackMultiSendTransactions encodes the transactions the way MultiSend expects them: each one is
+// operation (1 byte), to (20 bytes), value (32 bytes), data length (32 bytes) and data, tightly packed
+func PackMultiSendTransactions(transactions []MultiSendTransaction) []byte {
+	var packed []byte
+	for _, transaction := range transactions {
+		value := transaction.Value
+		if value == nil {
+			value = big.NewInt(0)
+		}
+
+		packed = append(packed, byte(transaction.Operation))
+		packed = append(packed, transaction.To.Bytes()...)
+		packed = append(packed, common.LeftPadBytes(value.Bytes(), 32)...)
+		packed = append(packed, common.LeftPadBytes(big.NewInt(int64(len(transaction.Data))).Bytes(), 32)...)
+		packed = append(packed, transaction.Data...)
+	}
+	return packed
+}
+
+// GetMultiSendCalldata returns the calldata of multiSend(bytes) for the given batch. The Safe must
+// DelegateCall the MultiSend contract with this calldata.
+func GetMultiSendCalldata(transactions []MultiSendTransaction) ([]byte, error) {
+	if len(transactions) == 0 {
+		return nil, fmt.Errorf("no transactions to batch")
+	}
+
+	multiSendAbi, multiSendAbiErr := abi.JSON(strings.NewReader(MultiSendABI))
+	if multiSendAbiErr != nil {
+		return nil, multiSendAbiErr
+	}
+
+	return multiSendAbi.Pack("multiSend", PackMultiSendTransactions(transactions))
+}
+
+// UnpackMultiSendTransactions is the inverse of PackMultiSendTransactions
+func UnpackMultiSendTransactions(packed []byte) ([]MultiSendTransaction, error) {
+	var transactions []MultiSendTransaction
+	for offset := 0; offset < len(packed); {
+		if len(packed)-offset < 85 {
+			return nil, fmt.Errorf("truncated MultiSend transaction at offset %d", offset)
+		}
+
+		transaction := MultiSendTransaction{
+			Operation: OperationType(packed[offset]),
+			To:        common.BytesToAddress(packed[offset+1 : offset+21]),
+			Value:     new(big.Int).SetBytes(packed[offset+21 : offset+53]),
+		}
+
+		dataLength := new(big.Int).SetBytes(packed[offset+53 : offset+85])
+		offset += 85
+		if !dataLength.IsInt64() || dataLength.Int64() > int64(len(packed)-offset) {
+			return nil, fmt.Errorf("MultiSend transaction data length %s exceeds the batch", dataLength.String())
+		}
+
+		transaction.Data = packed[offset : offset+int(dataLength.Int64())]
+		offset += int(dataLength.Int64())
+
+		transactions = append(transactions, transaction)
+	}
+	return transactions, nil
+}
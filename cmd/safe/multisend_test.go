@@ -0,0 +1,56 @@
+package safe
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestMultiSendRoundTrip(t *testing.T) {
+	transactions := []MultiSendTransaction{
+		{Operation: Call, To: common.HexToAddress("0x1111111111111111111111111111111111111111"), Value: big.NewInt(1_000_000_000_000_000_000), Data: nil},
+		{Operation: Call, To: common.HexToAddress("0x2222222222222222222222222222222222222222"), Value: big.NewInt(0), Data: common.FromHex("0xa9059cbb000000000000000000000000333333333333333333333333333333333333333300000000000000000000000000000000000000000000000000000000000003e8")},
+	}
+
+	calldata, err := GetMultiSendCalldata(transactions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	multiSendAbi, err := abi.JSON(strings.NewReader(MultiSendABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	args, err := multiSendAbi.Methods["multiSend"].Inputs.Unpack(calldata[4:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	packed := args[0].([]byte)
+	if len(packed) != 2*85+len(transactions[1].Data) {
+		t.Errorf("unexpected packed length %d", len(packed))
+	}
+
+	unpacked, err := UnpackMultiSendTransactions(packed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unpacked) != len(transactions) {
+		t.Fatalf("expected %d transactions, got %d", len(transactions), len(unpacked))
+	}
+	for i, transaction := range transactions {
+		if unpacked[i].Operation != transaction.Operation || unpacked[i].To != transaction.To || unpacked[i].Value.Cmp(transaction.Value) != 0 || !bytes.Equal(unpacked[i].Data, transaction.Data) {
+			t.Errorf("transaction %d: expected %+v, got %+v", i, transaction, unpacked[i])
+		}
+	}
+
+	if _, err := UnpackMultiSendTransactions(packed[:len(packed)-1]); err == nil {
+		t.Error("expected an error on a truncated batch")
+	}
+	if _, err := GetMultiSendCalldata(nil); err == nil {
+		t.Error("expected an error on an empty batch")
+	}
+}
@@ -0,0 +1,186 @@
+package safe
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/G7DAO/bifrost/bindings/GnosisSafe"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// Owners are stored in a linked list whose head is the sentinel address
+// Source: https://github.com/safe-global/safe-smart-account/blob/v1.3.0/contracts/base/OwnerManager.sol#L15
+var SENTINEL_OWNERS = common.HexToAddress("0x0000000000000000000000000000000000000001")
+
+// GetOwnersAndThreshold returns the current owners and threshold of the Safe
+func GetOwnersAndThreshold(client *ethclient.Client, safeAddress common.Address) ([]common.Address, *big.Int, error) {
+	safeInstance, err := GnosisSafe.NewGnosisSafe(safeAddress, client)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create GnosisSafe instance: %v", err)
+	}
+
+	owners, err := safeInstance.GetOwners(&bind.CallOpts{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch owners from Safe contract: %v", err)
+	}
+
+	threshold, err := safeInstance.GetThreshold(&bind.CallOpts{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch threshold from Safe contract: %v", err)
+	}
+
+	return owners, threshold, nil
+}
+
+// GetPrevOwner returns the owner that points to the given owner in the Safe's owners linked list.
+// getOwners() walks the list from the sentinel, so the previous owner is the preceding array element
+// or the sentinel for the first owner.
+func GetPrevOwner(owners []common.Address, owner common.Address) (common.Address, error) {
+	for i, o := range owners {
+		if o == owner {
+			if i == 0 {
+				return SENTINEL_OWNERS, nil
+			}
+			return owners[i-1], nil
+		}
+	}
+	return common.Address{}, fmt.Errorf("%s is not an owner of the Safe", owner.Hex())
+}
+
+func isOwner(owners []common.Address, owner common.Address) bool {
+	for _, o := range owners {
+		if o == owner {
+			return true
+		}
+	}
+	return false
+}
+
+func validateThreshold(threshold *big.Int, ownerCount int) error {
+	if threshold.Sign() <= 0 || threshold.Cmp(big.NewInt(int64(ownerCount))) > 0 {
+		return fmt.Errorf("threshold must be between 1 and %d, got %s", ownerCount, threshold.String())
+	}
+	return nil
+}
+
+// GetAddOwnerCalldata returns the calldata of addOwnerWithThreshold. If threshold is nil the current
+// threshold is kept.
+func GetAddOwnerCalldata(owners []common.Address, currentThreshold *big.Int, owner common.Address, threshold *big.Int) ([]byte, error) {
+	if owner == (common.Address{}) || owner == SENTINEL_OWNERS {
+		return nil, fmt.Errorf("invalid owner address: %s", owner.Hex())
+	}
+	if isOwner(owners, owner) {
+		return nil, fmt.Errorf("%s is already an owner of the Safe", owner.Hex())
+	}
+
+	if threshold == nil {
+		threshold = currentThreshold
+	}
+	if err := validateThreshold(threshold, len(owners)+1); err != nil {
+		return nil, err
+	}
+
+	safeAbi, err := GnosisSafe.GnosisSafeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	return safeAbi.Pack("addOwnerWithThreshold", owner, threshold)
+}
+
+// GetRemoveOwnerCalldata returns the calldata of removeOwner with the prevOwner looked up from the
+// owners list. If threshold is nil the current threshold is kept, lowered if needed so that it does
+// not exceed the remaining number of owners.
+func GetRemoveOwnerCalldata(owners []common.Address, currentThreshold *big.Int, owner common.Address, threshold *big.Int) ([]byte, error) {
+	prevOwner, err := GetPrevOwner(owners, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	remainingOwners := len(owners) - 1
+	if remainingOwners == 0 {
+		return nil, fmt.Errorf("cannot remove the last owner of the Safe")
+	}
+
+	if threshold == nil {
+		threshold = new(big.Int).Set(currentThreshold)
+		if threshold.Cmp(big.NewInt(int64(remainingOwners))) > 0 {
+			threshold.SetInt64(int64(remainingOwners))
+			fmt.Println("Lowering threshold to", threshold.String(), "to match the remaining owners")
+		}
+	}
+	if err := validateThreshold(threshold, remainingOwners); err != nil {
+		return nil, err
+	}
+
+	safeAbi, err := GnosisSafe.GnosisSafeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	return safeAbi.Pack("removeOwner", prevOwner, owner, threshold)
+}
+
+// GetSwapOwnerCalldata returns the calldata of swapOwner with the prevOwner looked up from the owners list
+func GetSwapOwnerCalldata(owners []common.Address, oldOwner common.Address, newOwner common.Address) ([]byte, error) {
+	prevOwner, err := GetPrevOwner(owners, oldOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	if newOwner == (common.Address{}) || newOwner == SENTINEL_OWNERS {
+		return nil, fmt.Errorf("invalid new owner address: %s", newOwner.Hex())
+	}
+	if isOwner(owners, newOwner) {
+		return nil, fmt.Errorf("%s is already an owner of the Safe", newOwner.Hex())
+	}
+
+	safeAbi, err := GnosisSafe.GnosisSafeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	return safeAbi.Pack("swapOwner", prevOwner, oldOwner, newOwner)
+}
+
+// GetChangeThresholdCalldata returns the calldata of changeThreshold
+func GetChangeThresholdCalldata(owners []common.Address, threshold *big.Int) ([]byte, error) {
+	if err := validateThreshold(threshold, len(owners)); err != nil {
+		return nil, err
+	}
+
+	safeAbi, err := GnosisSafe.GnosisSafeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	return safeAbi.Pack("changeThreshold", threshold)
+}
+
+// ProposeSafeSelfCall proposes a call from the Safe to itself, which is how owners and threshold are managed
+func ProposeSafeSelfCall(rpc string, keyFile string, password string, safeFlags *Flags, buildCalldata func(owners []common.Address, threshold *big.Int) ([]byte, error)) error {
+	client, clientErr := ethclient.DialContext(context.Background(), rpc)
+	if clientErr != nil {
+		return clientErr
+	}
+
+	key, keyErr := GnosisSafe.KeyFromFile(keyFile, password)
+	if keyErr != nil {
+		return keyErr
+	}
+
+	owners, threshold, ownersErr := GetOwnersAndThreshold(client, safeFlags.Address)
+	if ownersErr != nil {
+		return ownersErr
+	}
+
+	calldata, calldataErr := buildCalldata(owners, threshold)
+	if calldataErr != nil {
+		return calldataErr
+	}
+
+	return CreateSafeProposal(client, key, safeFlags.Address, safeFlags.Address, calldata, big.NewInt(0), safeFlags.Api, Call, safeFlags.Nonce)
+}
@@ -0,0 +1,160 @@
+package safe
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/G7DAO/bifrost/bindings/GnosisSafe"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var testOwners = []common.Address{
+	common.HexToAddress("0x1111111111111111111111111111111111111111"),
+	common.HexToAddress("0x2222222222222222222222222222222222222222"),
+	common.HexToAddress("0x3333333333333333333333333333333333333333"),
+}
+
+// unpackSafeCall checks the selector of the Safe calldata and returns its arguments
+func unpackSafeCall(t *testing.T, method string, calldata []byte) []interface{} {
+	t.Helper()
+
+	safeAbi, err := GnosisSafe.GnosisSafeMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(calldata) < 4 || common.Bytes2Hex(calldata[:4]) != common.Bytes2Hex(safeAbi.Methods[method].ID) {
+		t.Fatalf("expected a call to %s, got %x", method, calldata)
+	}
+	args, err := safeAbi.Methods[method].Inputs.Unpack(calldata[4:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return args
+}
+
+func TestGetPrevOwner(t *testing.T) {
+	testCases := []struct {
+		name     string
+		owner    common.Address
+		expected common.Address
+		fails    bool
+	}{
+		{name: "first owner", owner: testOwners[0], expected: SENTINEL_OWNERS},
+		{name: "middle owner", owner: testOwners[1], expected: testOwners[0]},
+		{name: "last owner", owner: testOwners[2], expected: testOwners[1]},
+		{name: "unknown owner", owner: common.HexToAddress("0x4444444444444444444444444444444444444444"), fails: true},
+	}
+	for _, testCase := range testCases {
+		prevOwner, err := GetPrevOwner(testOwners, testCase.owner)
+		if testCase.fails {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %s", testCase.name, prevOwner.Hex())
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", testCase.name, err)
+			continue
+		}
+		if prevOwner != testCase.expected {
+			t.Errorf("%s: expected prevOwner %s, got %s", testCase.name, testCase.expected.Hex(), prevOwner.Hex())
+		}
+	}
+}
+
+func TestGetRemoveOwnerCalldata(t *testing.T) {
+	testCases := []struct {
+		name              string
+		owners            []common.Address
+		currentThreshold  int64
+		owner             common.Address
+		threshold         *big.Int
+		expectedPrevOwner common.Address
+		expectedThreshold int64
+		fails             bool
+	}{
+		{name: "first owner keeps the threshold", owners: testOwners, currentThreshold: 2, owner: testOwners[0], expectedPrevOwner: SENTINEL_OWNERS, expectedThreshold: 2},
+		{name: "last owner keeps the threshold", owners: testOwners, currentThreshold: 1, owner: testOwners[2], expectedPrevOwner: testOwners[1], expectedThreshold: 1},
+		{name: "threshold above the remaining owners is lowered", owners: testOwners, currentThreshold: 3, owner: testOwners[1], expectedPrevOwner: testOwners[0], expectedThreshold: 2},
+		{name: "explicit threshold", owners: testOwners, currentThreshold: 3, owner: testOwners[1], threshold: big.NewInt(1), expectedPrevOwner: testOwners[0], expectedThreshold: 1},
+		{name: "explicit threshold of 0", owners: testOwners, currentThreshold: 2, owner: testOwners[1], threshold: big.NewInt(0), fails: true},
+		{name: "explicit threshold above the remaining owners", owners: testOwners, currentThreshold: 2, owner: testOwners[1], threshold: big.NewInt(3), fails: true},
+		{name: "last remaining owner", owners: testOwners[:1], currentThreshold: 1, owner: testOwners[0], fails: true},
+		{name: "unknown owner", owners: testOwners, currentThreshold: 2, owner: common.HexToAddress("0x4444444444444444444444444444444444444444"), fails: true},
+	}
+	for _, testCase := range testCases {
+		calldata, err := GetRemoveOwnerCalldata(testCase.owners, big.NewInt(testCase.currentThreshold), testCase.owner, testCase.threshold)
+		if testCase.fails {
+			if err == nil {
+				t.Errorf("%s: expected an error", testCase.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", testCase.name, err)
+			continue
+		}
+
+		args := unpackSafeCall(t, "removeOwner", calldata)
+		if args[0].(common.Address) != testCase.expectedPrevOwner || args[1].(common.Address) != testCase.owner {
+			t.Errorf("%s: expected removeOwner(%s, %s), got removeOwner(%s, %s)", testCase.name, testCase.expectedPrevOwner.Hex(), testCase.owner.Hex(), args[0].(common.Address).Hex(), args[1].(common.Address).Hex())
+		}
+		if threshold := args[2].(*big.Int); threshold.Int64() != testCase.expectedThreshold {
+			t.Errorf("%s: expected threshold %d, got %s", testCase.name, testCase.expectedThreshold, threshold.String())
+		}
+	}
+}
+
+func TestGetSwapOwnerCalldata(t *testing.T) {
+	newOwner := common.HexToAddress("0x4444444444444444444444444444444444444444")
+
+	testCases := []struct {
+		name              string
+		oldOwner          common.Address
+		newOwner          common.Address
+		expectedPrevOwner common.Address
+		fails             bool
+	}{
+		{name: "first owner", oldOwner: testOwners[0], newOwner: newOwner, expectedPrevOwner: SENTINEL_OWNERS},
+		{name: "middle owner", oldOwner: testOwners[1], newOwner: newOwner, expectedPrevOwner: testOwners[0]},
+		{name: "last owner", oldOwner: testOwners[2], newOwner: newOwner, expectedPrevOwner: testOwners[1]},
+		{name: "unknown old owner", oldOwner: newOwner, newOwner: common.HexToAddress("0x5555555555555555555555555555555555555555"), fails: true},
+		{name: "new owner already an owner", oldOwner: testOwners[0], newOwner: testOwners[1], fails: true},
+		{name: "sentinel as new owner", oldOwner: testOwners[0], newOwner: SENTINEL_OWNERS, fails: true},
+		{name: "zero address as new owner", oldOwner: testOwners[0], newOwner: common.Address{}, fails: true},
+	}
+	for _, testCase := range testCases {
+		calldata, err := GetSwapOwnerCalldata(testOwners, testCase.oldOwner, testCase.newOwner)
+		if testCase.fails {
+			if err == nil {
+				t.Errorf("%s: expected an error", testCase.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", testCase.name, err)
+			continue
+		}
+
+		args := unpackSafeCall(t, "swapOwner", calldata)
+		if args[0].(common.Address) != testCase.expectedPrevOwner || args[1].(common.Address) != testCase.oldOwner || args[2].(common.Address) != testCase.newOwner {
+			t.Errorf("%s: unexpected swapOwner(%s, %s, %s)", testCase.name, args[0].(common.Address).Hex(), args[1].(common.Address).Hex(), args[2].(common.Address).Hex())
+		}
+	}
+}
+
+func TestGetChangeThresholdCalldata(t *testing.T) {
+	for _, threshold := range []int64{0, 4} {
+		if _, err := GetChangeThresholdCalldata(testOwners, big.NewInt(threshold)); err == nil {
+			t.Errorf("expected an error on a threshold of %d with %d owners", threshold, len(testOwners))
+		}
+	}
+
+	calldata, err := GetChangeThresholdCalldata(testOwners, big.NewInt(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if threshold := unpackSafeCall(t, "changeThreshold", calldata)[0].(*big.Int); threshold.Int64() != 3 {
+		t.Errorf("expected threshold 3, got %s", threshold.String())
+	}
+}
@@ -0,0 +1,236 @@
+package safe
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/G7DAO/bifrost/cmd/decode"
+	"github.com/G7DAO/bifrost/cmd/safe/txservice"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ReviewTransaction is a Safe transaction as returned by the Safe Transaction Service, or as
+// written to a file by hand
+type ReviewTransaction struct {
+	Safe       string          `json:"safe"`
+	To         string          `json:"to"`
+	Value      string          `json:"value"`
+	Data       string          `json:"data"`
+	Operation  OperationType   `json:"operation"`
+	Nonce      json.RawMessage `json:"nonce"`
+	SafeTxHash string          `json:"safeTxHash"`
+}
+
+// LoadReviewTransaction reads a Safe transaction from a JSON file
+func LoadReviewTransaction(path string) (ReviewTransaction, error) {
+	var transaction ReviewTransaction
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return transaction, err
+	}
+
+	if err := json.Unmarshal(contents, &transaction); err != nil {
+		return transaction, fmt.Errorf("failed to parse %s: %v", path, err)
+	}
+	return transaction, nil
+}
+
+// FetchReviewTransaction fetches a pending Safe transaction by its safeTxHash from the Safe
+// Transaction Service at safeApi
+func FetchReviewTransaction(safeApi string, safeTxHash common.Hash) (ReviewTransaction, error) {
+	transaction, err := txservice.NewClient(safeApi).GetMultisigTransaction(context.Background(), safeTxHash)
+	if err != nil {
+		return ReviewTransaction{}, err
+	}
+
+	return ReviewTransaction{
+		Safe:       transaction.Safe,
+		To:         transaction.To,
+		Value:      transaction.Value,
+		Data:       transaction.Data,
+		Operation:  OperationType(transaction.Operation),
+		Nonce:      json.RawMessage(fmt.Sprintf("%d", transaction.Nonce)),
+		SafeTxHash: transaction.SafeTxHash,
+	}, nil
+}
+
+// Review prints a human-readable breakdown of the Safe transaction. Calldata is decoded against
+// every ABI in bindings/ plus MultiSend, and calls embedded in MultiSend batches, retryable tickets,
+// teleports and nested Safe transactions are decoded recursively.
+func Review(w io.Writer, transaction ReviewTransaction) error {
+	registry, err := decode.NewBindingsRegistry()
+	if err != nil {
+		return err
+	}
+	if err := registry.AddJSON("MultiSend", MultiSendABI); err != nil {
+		return err
+	}
+
+	if !common.IsHexAddress(transaction.To) {
+		return fmt.Errorf("invalid \"to\" address: %s", transaction.To)
+	}
+	to := common.HexToAddress(transaction.To)
+
+	value := big.NewInt(0)
+	if transaction.Value != "" {
+		if _, ok := value.SetString(transaction.Value, 10); !ok {
+			return fmt.Errorf("invalid value: %s", transaction.Value)
+		}
+	}
+
+	data := common.FromHex(transaction.Data)
+
+	if transaction.SafeTxHash != "" {
+		fmt.Fprintln(w, "SafeTxHash:", transaction.SafeTxHash)
+	}
+	if transaction.Safe != "" {
+		fmt.Fprintln(w, "Safe:      ", transaction.Safe)
+	}
+	if len(transaction.Nonce) > 0 {
+		fmt.Fprintln(w, "Nonce:     ", strings.Trim(string(transaction.Nonce), "\""))
+	}
+
+	reviewer := &reviewer{w: w, registry: registry}
+	reviewer.call(0, common.HexToAddress(transaction.Safe), transaction.Operation, to, value, data)
+
+	return nil
+}
+
+type reviewer struct {
+	w        io.Writer
+	registry *decode.Registry
+}
+
+func (r *reviewer) printf(depth int, format string, args ...interface{}) {
+	fmt.Fprintf(r.w, strings.Repeat("    ", depth)+format+"\n", args...)
+}
+
+func (r *reviewer) call(depth int, from common.Address, operation OperationType, to common.Address, value *big.Int, data []byte) {
+	if from != (common.Address{}) {
+		r.printf(depth, "From:       %s", from.Hex())
+	}
+	r.printf(depth, "To:         %s", to.Hex())
+	r.printf(depth, "Value:      %s", value.String())
+	r.printf(depth, "Operation:  %s", operation.String())
+
+	if len(data) == 0 {
+		r.printf(depth, "Call:       (none, plain transfer)")
+		return
+	}
+
+	call, err := r.registry.DecodeCall(data)
+	if err != nil {
+		r.printf(depth, "Call:       could not decode: %s", err.Error())
+		r.printf(depth, "Data:       0x%s", common.Bytes2Hex(data))
+		return
+	}
+
+	r.printf(depth, "Call:       %s", call.Name())
+	if call.Method.RawName != "multiSend" {
+		for i, input := range call.Method.Inputs {
+			r.printf(depth+1, "%s: %s", argName(input, i), decode.FormatValue(call.Values[i]))
+		}
+	}
+
+	switch call.Method.RawName {
+	case "multiSend":
+		packed, _ := call.Args["transactions"].([]byte)
+		transactions, err := UnpackMultiSendTransactions(packed)
+		if err != nil {
+			r.printf(depth+1, "could not unpack MultiSend batch: %s", err.Error())
+			return
+		}
+		for i, transaction := range transactions {
+			r.printf(depth+1, "[%d]", i)
+			// MultiSend is delegate called, so batched calls are made by the Safe itself
+			r.call(depth+2, from, transaction.Operation, transaction.To, transaction.Value, transaction.Data)
+		}
+
+	case "createRetryableTicket", "unsafeCreateRetryableTicket":
+		l2Sender := from
+		if from != (common.Address{}) {
+			l2Sender = applyL1ToL2Alias(from)
+			r.printf(depth+1, "L2 sender (aliased %s): %s", from.Hex(), l2Sender.Hex())
+		}
+		r.printf(depth+1, "L2 call:")
+		l2Data, _ := call.Args["data"].([]byte)
+		l2CallValue, _ := call.Args["l2CallValue"].(*big.Int)
+		l2To, _ := call.Args["to"].(common.Address)
+		r.call(depth+2, l2Sender, Call, l2To, l2CallValue, l2Data)
+
+	case "outboundTransfer", "outboundTransferCustomRefund":
+		r.outboundTransferData(depth+1, call.Args["_data"])
+
+	case "teleport":
+		params := reflect.ValueOf(call.Args["params"])
+		if params.Kind() == reflect.Struct {
+			l3CallData, _ := params.FieldByName("L3CallData").Interface().([]byte)
+			l3To, _ := params.FieldByName("To").Interface().(common.Address)
+			if len(l3CallData) > 0 {
+				r.printf(depth+1, "L3 call:")
+				r.call(depth+2, common.Address{}, Call, l3To, big.NewInt(0), l3CallData)
+			}
+		}
+
+	case "execTransaction":
+		innerData, _ := call.Args["data"].([]byte)
+		innerValue, _ := call.Args["value"].(*big.Int)
+		innerTo, _ := call.Args["to"].(common.Address)
+		innerOperation, _ := call.Args["operation"].(uint8)
+		r.printf(depth+1, "Inner Safe transaction:")
+		r.call(depth+2, to, OperationType(innerOperation), innerTo, innerValue, innerData)
+	}
+}
+
+// outboundTransferData decodes the _data argument of the gateway routers, which is
+// (maxSubmissionCost, callHookData) for ETH fee chains and (maxSubmissionCost, callHookData,
+// tokenTotalFeeAmount) for custom fee token chains
+func (r *reviewer) outboundTransferData(depth int, raw interface{}) {
+	data, ok := raw.([]byte)
+	if !ok || len(data) == 0 {
+		return
+	}
+
+	uint256Type, _ := abi.NewType("uint256", "", nil)
+	bytesType, _ := abi.NewType("bytes", "", nil)
+
+	customFeeArguments := abi.Arguments{{Name: "maxSubmissionCost", Type: uint256Type}, {Name: "callHookData", Type: bytesType}, {Name: "tokenTotalFeeAmount", Type: uint256Type}}
+	ethFeeArguments := abi.Arguments{{Name: "maxSubmissionCost", Type: uint256Type}, {Name: "callHookData", Type: bytesType}}
+
+	for _, arguments := range []abi.Arguments{customFeeArguments, ethFeeArguments} {
+		values := make(map[string]interface{})
+		if err := arguments.UnpackIntoMap(values, data); err != nil {
+			continue
+		}
+		r.printf(depth, "_data decoded:")
+		for _, argument := range arguments {
+			r.printf(depth+1, "%s: %s", argument.Name, decode.FormatValue(values[argument.Name]))
+		}
+		return
+	}
+}
+
+func argName(input abi.Argument, index int) string {
+	if input.Name == "" {
+		return fmt.Sprintf("arg%d", index)
+	}
+	return input.Name
+}
+
+// Source: https://github.com/OffchainLabs/nitro/blob/057bf836fcf719e803b0486914bc957134f691fd/arbos/util/util.go#L204
+func applyL1ToL2Alias(l1Address common.Address) common.Address {
+	aliasOffset, _ := new(big.Int).SetString("0x1111000000000000000000000000000000001111", 0)
+	sumBytes := new(big.Int).Add(new(big.Int).SetBytes(l1Address.Bytes()), aliasOffset).Bytes()
+	if len(sumBytes) > 20 {
+		sumBytes = sumBytes[len(sumBytes)-20:]
+	}
+	return common.BytesToAddress(sumBytes)
+}
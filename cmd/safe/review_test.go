@@ -0,0 +1,76 @@
+package safe
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/G7DAO/bifrost/bindings/ERC20Inbox"
+	"github.com/G7DAO/bifrost/bindings/L1GatewayRouter"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestReviewRetryableTicket(t *testing.T) {
+	safeAddress := common.HexToAddress("0x0000000000000000000000000000000000001000")
+	inbox := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	router := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	token := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	recipient := common.HexToAddress("0x4444444444444444444444444444444444444444")
+
+	uint256Type, _ := abi.NewType("uint256", "", nil)
+	bytesType, _ := abi.NewType("bytes", "", nil)
+	outboundData, err := abi.Arguments{{Type: uint256Type}, {Type: bytesType}}.Pack(big.NewInt(12345), []byte{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	routerAbi, err := L1GatewayRouter.L1GatewayRouterMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	outboundTransfer, err := routerAbi.Pack("outboundTransfer", token, recipient, big.NewInt(1000), big.NewInt(300_000), big.NewInt(100_000_000), outboundData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inboxAbi, err := ERC20Inbox.ERC20InboxMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	retryableTicket, err := inboxAbi.Pack("createRetryableTicket", router, big.NewInt(0), big.NewInt(0), safeAddress, safeAddress, big.NewInt(300_000), big.NewInt(100_000_000), big.NewInt(0), outboundTransfer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var output bytes.Buffer
+	err = Review(&output, ReviewTransaction{
+		Safe:      safeAddress.Hex(),
+		To:        inbox.Hex(),
+		Value:     "0",
+		Data:      common.Bytes2Hex(retryableTicket),
+		Operation: Call,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The L2 call is made by the aliased Safe: 0x1000 + 0x1111000000000000000000000000000000001111
+	expected := []string{
+		"createRetryableTicket",
+		"L2 sender (aliased " + safeAddress.Hex() + "): " + common.HexToAddress("0x1111000000000000000000000000000000002111").Hex(),
+		"To:         " + router.Hex(),
+		"outboundTransfer",
+		recipient.Hex(),
+		"maxSubmissionCost: 12345",
+	}
+	for _, line := range expected {
+		if !strings.Contains(output.String(), line) {
+			t.Errorf("expected the review to contain %q, got:\n%s", line, output.String())
+		}
+	}
+	if strings.Contains(output.String(), "could not decode") {
+		t.Errorf("expected every call to be decoded, got:\n%s", output.String())
+	}
+}
@@ -1,14 +1,12 @@
-package arbitrum_bifrost
+package safe
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"math/big"
-	"net/http"
 
 	"github.com/G7DAO/bifrost/bindings/GnosisSafe"
+	"github.com/G7DAO/bifrost/cmd/safe/txservice"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
@@ -75,11 +73,18 @@ func CreateSafeProposal(client *ethclient.Client, key *keystore.Key, safeAddress
 	nonce := big.NewInt(0)
 	if safeNonce == nil {
 		// Fetch the current nonce from the Safe contract
-		fetchNonce, err := safeInstance.Nonce(&bind.CallOpts{})
+		onChainNonce, err := safeInstance.Nonce(&bind.CallOpts{})
 		if err != nil {
 			return fmt.Errorf("failed to fetch nonce from Safe contract: %v", err)
 		}
-		nonce = fetchNonce
+
+		// Skip the nonces already taken by proposals waiting in the queue
+		nextNonce, err := NextNonce(context.Background(), txservice.NewClient(safeApi), safeAddress, onChainNonce)
+		if err != nil {
+			return fmt.Errorf("failed to fetch queued proposals from Safe Transaction Service: %v", err)
+		}
+		nonce = nextNonce
+		fmt.Println("Using Safe nonce", nonce.String())
 	} else {
 		nonce = safeNonce
 	}
@@ -115,51 +120,58 @@ func CreateSafeProposal(client *ethclient.Client, key *keystore.Key, safeAddress
 	// Convert signature to hex
 	senderSignature := "0x" + common.Bytes2Hex(signature)
 
-	// Prepare the request body
-	requestBody := map[string]interface{}{
-		"to":             safeTransactionData.To,
-		"value":          safeTransactionData.Value,
-		"data":           "0x" + safeTransactionData.Data,
-		"operation":      int(safeTransactionData.Operation),
-		"safeTxGas":      fmt.Sprintf("%d", safeTransactionData.SafeTxGas),
-		"baseGas":        fmt.Sprintf("%d", safeTransactionData.BaseGas),
-		"gasPrice":       safeTransactionData.GasPrice,
-		"gasToken":       safeTransactionData.GasToken,
-		"refundReceiver": safeTransactionData.RefundReceiver,
-		"nonce":          fmt.Sprintf("%d", safeTransactionData.Nonce),
-		"safeTxHash":     safeTxHash.Hex(),
-		"sender":         key.Address.Hex(),
-		"signature":      senderSignature,
-		"origin":         fmt.Sprintf("{\"url\":\"%s\",\"name\":\"TokenSender Deployment\"}", safeApi),
-	}
-
-	// Marshal the request body to JSON
-	jsonBody, err := json.Marshal(requestBody)
-	if err != nil {
-		return fmt.Errorf("failed to marshal request body: %v", err)
+	proposal := txservice.Proposal{
+		To:                      safeTransactionData.To,
+		Value:                   safeTransactionData.Value,
+		Data:                    "0x" + safeTransactionData.Data,
+		Operation:               uint8(safeTransactionData.Operation),
+		SafeTxGas:               fmt.Sprintf("%d", safeTransactionData.SafeTxGas),
+		BaseGas:                 fmt.Sprintf("%d", safeTransactionData.BaseGas),
+		GasPrice:                safeTransactionData.GasPrice,
+		GasToken:                safeTransactionData.GasToken,
+		RefundReceiver:          safeTransactionData.RefundReceiver,
+		Nonce:                   fmt.Sprintf("%d", safeTransactionData.Nonce),
+		ContractTransactionHash: safeTxHash.Hex(),
+		Sender:                  key.Address.Hex(),
+		Signature:               senderSignature,
+		Origin:                  fmt.Sprintf("{\"url\":\"%s\",\"name\":\"bifrost\"}", safeApi),
 	}
 
-	// Send the request to the Safe Transaction Service
-	req, err := http.NewRequest("POST", safeApi, bytes.NewBuffer(jsonBody))
-	if err != nil {
-		return fmt.Errorf("failed to create request: %v", err)
+	// Send the proposal to the Safe Transaction Service
+	proposeErr := txservice.NewClient(safeApi).ProposeTransaction(context.Background(), safeAddress, proposal)
+	if proposeErr != nil {
+		return fmt.Errorf("failed to propose transaction %s: %v", safeTxHash.Hex(), proposeErr)
 	}
 
-	req.Header.Set("Content-Type", "application/json")
+	fmt.Println("Safe proposal created successfully, safeTxHash:", safeTxHash.Hex())
+	return nil
+}
 
-	httpClient := &http.Client{}
-	resp, err := httpClient.Do(req)
+// NextNonce returns the first nonce after onChainNonce that is not used by a proposal queued in the
+// Safe Transaction Service
+func NextNonce(ctx context.Context, service *txservice.Client, safeAddress common.Address, onChainNonce *big.Int) (*big.Int, error) {
+	executed := false
+	nonceGte := onChainNonce.Uint64()
+	queued, err := service.ListMultisigTransactions(ctx, safeAddress, txservice.MultisigTransactionFilter{Executed: &executed, NonceGte: &nonceGte})
 	if err != nil {
-		return fmt.Errorf("failed to send request: %v", err)
+		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	nextNonce := new(big.Int).Set(onChainNonce)
+	for _, transaction := range queued {
+		transactionNonce := new(big.Int).SetUint64(uint64(transaction.Nonce))
+		if transactionNonce.Cmp(nextNonce) >= 0 {
+			nextNonce.Add(transactionNonce, big.NewInt(1))
+		}
 	}
+	return nextNonce, nil
+}
 
-	fmt.Println("Safe proposal created successfully")
-	return nil
+// QueuedTransactions returns the proposals queued in the Safe Transaction Service with the given nonce
+func QueuedTransactions(ctx context.Context, service *txservice.Client, safeAddress common.Address, nonce *big.Int) ([]txservice.MultisigTransaction, error) {
+	executed := false
+	exactNonce := nonce.Uint64()
+	return service.ListMultisigTransactions(ctx, safeAddress, txservice.MultisigTransactionFilter{Executed: &executed, Nonce: &exactNonce})
 }
 
 func CalculateSafeTxHash(safeAddress common.Address, txData SafeTransactionData, chainID *big.Int) (common.Hash, error) {
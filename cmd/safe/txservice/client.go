@@ -0,0 +1,474 @@
+package txservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	DefaultTimeout    = 30 * time.Second
+	DefaultMaxRetries = 3
+	DefaultBackoff    = 500 * time.Millisecond
+)
+
+// Source: https://docs.safe.global/advanced/smart-account-supported-networks?service=Transaction+Service
+var DefaultURLs = map[uint64]string{
+	1:        "https://safe-transaction-mainnet.safe.global",
+	10:       "https://safe-transaction-optimism.safe.global",
+	56:       "https://safe-transaction-bsc.safe.global",
+	100:      "https://safe-transaction-gnosis-chain.safe.global",
+	137:      "https://safe-transaction-polygon.safe.global",
+	324:      "https://safe-transaction-zksync.safe.global",
+	8453:     "https://safe-transaction-base.safe.global",
+	42161:    "https://safe-transaction-arbitrum.safe.global",
+	43114:    "https://safe-transaction-avalanche.safe.global",
+	84532:    "https://safe-transaction-base-sepolia.safe.global",
+	421614:   "https://safe-transaction-arbitrum-sepolia.safe.global",
+	11155111: "https://safe-transaction-sepolia.safe.global",
+	11155420: "https://safe-transaction-optimism-sepolia.safe.global",
+}
+
+// DefaultURL returns the URL of the Safe Transaction Service hosted by Safe for the given chain
+func DefaultURL(chainID *big.Int) (string, error) {
+	if chainID.IsUint64() {
+		if serviceURL, ok := DefaultURLs[chainID.Uint64()]; ok {
+			return serviceURL, nil
+		}
+	}
+	return "", fmt.Errorf("no default Safe Transaction Service for chain %s, use --safe-api", chainID.String())
+}
+
+// legacyProposeURL matches the safe-client propose URLs that --safe-api used to take, e.g.
+// https://safe-client.safe.global/v1/chains/1/transactions/<safe>/propose
+var legacyProposeURL = regexp.MustCompile(`/v1/chains/(\d+)/transactions/0x[0-9a-fA-F]{40}/propose/?$`)
+
+// ServiceURL validates the base URL of a Safe Transaction Service. A safe-client propose URL, which
+// --safe-api used to take, is translated to the default service of its chain, and rejected if the
+// chain has none.
+func ServiceURL(api string) (string, error) {
+	parsed, err := url.Parse(api)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return "", fmt.Errorf("--safe-api %s is not a valid URL", api)
+	}
+
+	match := legacyProposeURL.FindStringSubmatch(parsed.Path)
+	if match == nil {
+		return strings.TrimSuffix(api, "/"), nil
+	}
+
+	chainID, _ := new(big.Int).SetString(match[1], 10)
+	serviceURL, err := DefaultURL(chainID)
+	if err != nil {
+		return "", fmt.Errorf("--safe-api %s is a safe-client propose URL, pass the base URL of the Safe Transaction Service of chain %s instead", api, chainID.String())
+	}
+	return serviceURL, nil
+}
+
+// Client is a client for the Safe Transaction Service API. Requests that fail with 429 or a 5xx
+// status, or that do not reach the service at all, are retried with exponential backoff.
+type Client struct {
+	BaseURL    string
+	HTTPClient *http.Client
+	MaxRetries int
+	Backoff    time.Duration
+}
+
+func NewClient(baseURL string) *Client {
+	return &Client{
+		BaseURL:    strings.TrimSuffix(baseURL, "/"),
+		HTTPClient: &http.Client{Timeout: DefaultTimeout},
+		MaxRetries: DefaultMaxRetries,
+		Backoff:    DefaultBackoff,
+	}
+}
+
+// APIError is returned when the Safe Transaction Service answers with an unexpected status. Message
+// holds the explanation from the response body, e.g. a nonce that was already used or a sender that
+// is not an owner.
+type APIError struct {
+	StatusCode int
+	Message    string
+	Body       string
+}
+
+// ErrNotFound can be matched with errors.Is against errors returned by the client
+var ErrNotFound = errors.New("not found")
+
+func (e *APIError) Is(target error) bool {
+	return target == ErrNotFound && e.StatusCode == http.StatusNotFound
+}
+
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("safe transaction service returned status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("safe transaction service returned status %d: %s", e.StatusCode, e.Message)
+}
+
+// parseErrorMessage flattens the error bodies of the service, which are either {"detail": "..."}
+// or a map of field names to lists of messages
+func parseErrorMessage(body []byte) string {
+	var fields map[string]interface{}
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return strings.TrimSpace(string(body))
+	}
+
+	if detail, ok := fields["detail"].(string); ok {
+		return detail
+	}
+
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	messages := make([]string, 0, len(keys))
+	for _, key := range keys {
+		var values []string
+		switch v := fields[key].(type) {
+		case []interface{}:
+			for _, item := range v {
+				values = append(values, fmt.Sprint(item))
+			}
+		default:
+			values = append(values, fmt.Sprint(v))
+		}
+
+		if key == "nonFieldErrors" || key == "non_field_errors" {
+			messages = append(messages, strings.Join(values, "; "))
+		} else {
+			messages = append(messages, key+": "+strings.Join(values, "; "))
+		}
+	}
+	return strings.Join(messages, ", ")
+}
+
+func isRetryable(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
+
+// retryDelay honours Retry-After (in seconds) and otherwise doubles the backoff on every attempt
+func (c *Client) retryDelay(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second
+		}
+	}
+	return c.Backoff * time.Duration(1<<attempt)
+}
+
+// do sends the request and decodes a successful JSON response into out, if out is not nil
+func (c *Client) do(ctx context.Context, method string, path string, query url.Values, body interface{}, out interface{}) error {
+	var payload []byte
+	if body != nil {
+		var err error
+		payload, err = json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request body: %v", err)
+		}
+	}
+
+	requestURL := c.BaseURL + path
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, method, requestURL, bytes.NewReader(payload))
+		if err != nil {
+			return fmt.Errorf("failed to create request: %v", err)
+		}
+		req.Header.Set("Accept", "application/json")
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+
+		resp, err := c.HTTPClient.Do(req)
+		if err != nil {
+			if attempt >= c.MaxRetries || ctx.Err() != nil {
+				return fmt.Errorf("failed to send request: %v", err)
+			}
+			if sleepErr := sleep(ctx, c.retryDelay(attempt, nil)); sleepErr != nil {
+				return sleepErr
+			}
+			continue
+		}
+
+		responseBody, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if readErr != nil {
+			return fmt.Errorf("failed to read response: %v", readErr)
+		}
+
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			if out == nil || len(responseBody) == 0 {
+				return nil
+			}
+			if err := json.Unmarshal(responseBody, out); err != nil {
+				return fmt.Errorf("failed to parse response: %v", err)
+			}
+			return nil
+		}
+
+		apiErr := &APIError{StatusCode: resp.StatusCode, Message: parseErrorMessage(responseBody), Body: string(responseBody)}
+		if !isRetryable(resp.StatusCode) || attempt >= c.MaxRetries {
+			return apiErr
+		}
+		if sleepErr := sleep(ctx, c.retryDelay(attempt, resp)); sleepErr != nil {
+			return sleepErr
+		}
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Uint64 accepts both JSON numbers and decimal strings, since versions of the service differ in how
+// they encode nonces and gas values
+type Uint64 uint64
+
+func (u *Uint64) UnmarshalJSON(data []byte) error {
+	raw := strings.Trim(string(data), "\"")
+	if raw == "" || raw == "null" {
+		*u = 0
+		return nil
+	}
+
+	value, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid integer %s: %v", string(data), err)
+	}
+	*u = Uint64(value)
+	return nil
+}
+
+// SafeInfo is the state of a Safe as indexed by the service
+type SafeInfo struct {
+	Address         string   `json:"address"`
+	Nonce           Uint64   `json:"nonce"`
+	Threshold       Uint64   `json:"threshold"`
+	Owners          []string `json:"owners"`
+	MasterCopy      string   `json:"masterCopy"`
+	Modules         []string `json:"modules"`
+	FallbackHandler string   `json:"fallbackHandler"`
+	Guard           string   `json:"guard"`
+	Version         string   `json:"version"`
+}
+
+// Proposal is the body of a new multisig transaction. ContractTransactionHash is the safeTxHash
+// and Signature is the proposer's signature of it.
+type Proposal struct {
+	To                      string `json:"to"`
+	Value                   string `json:"value"`
+	Data                    string `json:"data,omitempty"`
+	Operation               uint8  `json:"operation"`
+	SafeTxGas               string `json:"safeTxGas"`
+	BaseGas                 string `json:"baseGas"`
+	GasPrice                string `json:"gasPrice"`
+	GasToken                string `json:"gasToken"`
+	RefundReceiver          string `json:"refundReceiver"`
+	Nonce                   string `json:"nonce"`
+	ContractTransactionHash string `json:"contractTransactionHash"`
+	Sender                  string `json:"sender"`
+	Signature               string `json:"signature"`
+	Origin                  string `json:"origin,omitempty"`
+}
+
+// Confirmation is an owner's signature of a multisig transaction
+type Confirmation struct {
+	Owner           string `json:"owner"`
+	SubmissionDate  string `json:"submissionDate"`
+	TransactionHash string `json:"transactionHash"`
+	Signature       string `json:"signature"`
+	SignatureType   string `json:"signatureType"`
+}
+
+// MultisigTransaction is a Safe transaction known to the service, executed or not
+type MultisigTransaction struct {
+	Safe                  string         `json:"safe"`
+	To                    string         `json:"to"`
+	Value                 string         `json:"value"`
+	Data                  string         `json:"data"`
+	Operation             uint8          `json:"operation"`
+	GasToken              string         `json:"gasToken"`
+	SafeTxGas             Uint64         `json:"safeTxGas"`
+	BaseGas               Uint64         `json:"baseGas"`
+	GasPrice              string         `json:"gasPrice"`
+	RefundReceiver        string         `json:"refundReceiver"`
+	Nonce                 Uint64         `json:"nonce"`
+	SafeTxHash            string         `json:"safeTxHash"`
+	Proposer              string         `json:"proposer"`
+	SubmissionDate        string         `json:"submissionDate"`
+	ExecutionDate         string         `json:"executionDate"`
+	TransactionHash       string         `json:"transactionHash"`
+	IsExecuted            bool           `json:"isExecuted"`
+	IsSuccessful          *bool          `json:"isSuccessful"`
+	ConfirmationsRequired Uint64         `json:"confirmationsRequired"`
+	Confirmations         []Confirmation `json:"confirmations"`
+}
+
+// Delegate is an address allowed to propose transactions on behalf of a Safe owner
+type Delegate struct {
+	Safe      string `json:"safe,omitempty"`
+	Delegate  string `json:"delegate"`
+	Delegator string `json:"delegator"`
+	Label     string `json:"label"`
+}
+
+type page[T any] struct {
+	Count    int    `json:"count"`
+	Next     string `json:"next"`
+	Previous string `json:"previous"`
+	Results  []T    `json:"results"`
+}
+
+// MultisigTransactionFilter narrows down ListMultisigTransactions. Zero values are not sent.
+type MultisigTransactionFilter struct {
+	Executed *bool
+	Nonce    *uint64
+	NonceGte *uint64
+	Ordering string
+	Limit    int
+}
+
+func (f MultisigTransactionFilter) query() url.Values {
+	query := url.Values{}
+	if f.Executed != nil {
+		query.Set("executed", strconv.FormatBool(*f.Executed))
+	}
+	if f.Nonce != nil {
+		query.Set("nonce", strconv.FormatUint(*f.Nonce, 10))
+	}
+	if f.NonceGte != nil {
+		query.Set("nonce__gte", strconv.FormatUint(*f.NonceGte, 10))
+	}
+	if f.Ordering != "" {
+		query.Set("ordering", f.Ordering)
+	}
+	if f.Limit > 0 {
+		query.Set("limit", strconv.Itoa(f.Limit))
+	}
+	return query
+}
+
+// GetSafe returns the Safe as indexed by the service, including its current on-chain nonce
+func (c *Client) GetSafe(ctx context.Context, safeAddress common.Address) (*SafeInfo, error) {
+	var info SafeInfo
+	if err := c.do(ctx, http.MethodGet, "/api/v1/safes/"+safeAddress.Hex()+"/", nil, nil, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// ProposeTransaction submits a new multisig transaction signed by one of the owners or delegates
+func (c *Client) ProposeTransaction(ctx context.Context, safeAddress common.Address, proposal Proposal) error {
+	return c.do(ctx, http.MethodPost, "/api/v1/safes/"+safeAddress.Hex()+"/multisig-transactions/", nil, proposal, nil)
+}
+
+// GetMultisigTransaction returns the multisig transaction with the given safeTxHash
+func (c *Client) GetMultisigTransaction(ctx context.Context, safeTxHash common.Hash) (*MultisigTransaction, error) {
+	var transaction MultisigTransaction
+	if err := c.do(ctx, http.MethodGet, "/api/v1/multisig-transactions/"+safeTxHash.Hex()+"/", nil, nil, &transaction); err != nil {
+		return nil, err
+	}
+	return &transaction, nil
+}
+
+// ListMultisigTransactions returns the multisig transactions of the Safe matching the filter,
+// following pagination until every result has been fetched
+func (c *Client) ListMultisigTransactions(ctx context.Context, safeAddress common.Address, filter MultisigTransactionFilter) ([]MultisigTransaction, error) {
+	var transactions []MultisigTransaction
+	query := filter.query()
+	for offset := 0; ; {
+		if offset > 0 {
+			query.Set("offset", strconv.Itoa(offset))
+		}
+
+		var results page[MultisigTransaction]
+		if err := c.do(ctx, http.MethodGet, "/api/v1/safes/"+safeAddress.Hex()+"/multisig-transactions/", query, nil, &results); err != nil {
+			return nil, err
+		}
+
+		transactions = append(transactions, results.Results...)
+		offset += len(results.Results)
+		if results.Next == "" || len(results.Results) == 0 {
+			return transactions, nil
+		}
+	}
+}
+
+// GetConfirmations returns the owner signatures collected for the multisig transaction
+func (c *Client) GetConfirmations(ctx context.Context, safeTxHash common.Hash) ([]Confirmation, error) {
+	var results page[Confirmation]
+	if err := c.do(ctx, http.MethodGet, "/api/v1/multisig-transactions/"+safeTxHash.Hex()+"/confirmations/", nil, nil, &results); err != nil {
+		return nil, err
+	}
+	return results.Results, nil
+}
+
+// ConfirmTransaction adds an owner's signature of the safeTxHash to the multisig transaction
+func (c *Client) ConfirmTransaction(ctx context.Context, safeTxHash common.Hash, signature []byte) error {
+	body := map[string]string{"signature": "0x" + common.Bytes2Hex(signature)}
+	return c.do(ctx, http.MethodPost, "/api/v1/multisig-transactions/"+safeTxHash.Hex()+"/confirmations/", nil, body, nil)
+}
+
+// ListDelegates returns the delegates of the Safe
+func (c *Client) ListDelegates(ctx context.Context, safeAddress common.Address) ([]Delegate, error) {
+	var results page[Delegate]
+	query := url.Values{"safe": []string{safeAddress.Hex()}}
+	if err := c.do(ctx, http.MethodGet, "/api/v2/delegates/", query, nil, &results); err != nil {
+		return nil, err
+	}
+	return results.Results, nil
+}
+
+// AddDelegate registers delegate as a proposer for the delegator. The signature is the delegator's
+// signature of DelegateTypedDataHash.
+func (c *Client) AddDelegate(ctx context.Context, safeAddress *common.Address, delegate common.Address, delegator common.Address, label string, signature []byte) error {
+	body := map[string]string{
+		"delegate":  delegate.Hex(),
+		"delegator": delegator.Hex(),
+		"label":     label,
+		"signature": "0x" + common.Bytes2Hex(signature),
+	}
+	if safeAddress != nil {
+		body["safe"] = safeAddress.Hex()
+	}
+	return c.do(ctx, http.MethodPost, "/api/v2/delegates/", nil, body, nil)
+}
+
+// RemoveDelegate removes delegate from the delegator's proposers. The signature is the delegator's
+// signature of DelegateTypedDataHash.
+func (c *Client) RemoveDelegate(ctx context.Context, safeAddress *common.Address, delegate common.Address, delegator common.Address, signature []byte) error {
+	body := map[string]string{
+		"delegator": delegator.Hex(),
+		"signature": "0x" + common.Bytes2Hex(signature),
+	}
+	if safeAddress != nil {
+		body["safe"] = safeAddress.Hex()
+	}
+	return c.do(ctx, http.MethodDelete, "/api/v2/delegates/"+delegate.Hex()+"/", nil, body, nil)
+}
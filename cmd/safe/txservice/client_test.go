@@ -0,0 +1,345 @@
+package txservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	testSafe       = common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+	testSafeTxHash = common.HexToHash("0x1111111111111111111111111111111111111111111111111111111111111111")
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := NewClient(server.URL + "/")
+	client.Backoff = time.Millisecond
+	return client
+}
+
+func TestProposeTransaction(t *testing.T) {
+	var received Proposal
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/v1/safes/"+testSafe.Hex()+"/multisig-transactions/" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("unexpected content type %s", r.Header.Get("Content-Type"))
+		}
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("failed to decode body: %v", err)
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	proposal := Proposal{To: testSafe.Hex(), Value: "0", Nonce: "7", ContractTransactionHash: testSafeTxHash.Hex()}
+	if err := client.ProposeTransaction(context.Background(), testSafe, proposal); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if received.Nonce != "7" || received.ContractTransactionHash != testSafeTxHash.Hex() {
+		t.Errorf("unexpected proposal received: %+v", received)
+	}
+}
+
+func TestErrorBodyIsSurfaced(t *testing.T) {
+	cases := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{"detail", `{"detail": "Not found."}`, "Not found."},
+		{"nonFieldErrors", `{"nonFieldErrors": ["Signer=0xabc is not an owner or delegate"]}`, "Signer=0xabc is not an owner or delegate"},
+		{"fields", `{"nonce": ["Nonce=3 too low, safe nonce is 4"]}`, "nonce: Nonce=3 too low, safe nonce is 4"},
+		{"plain", "bad request", "bad request"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				io.WriteString(w, c.body)
+			})
+
+			err := client.ProposeTransaction(context.Background(), testSafe, Proposal{})
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected an APIError, got %v", err)
+			}
+			if apiErr.StatusCode != http.StatusUnprocessableEntity {
+				t.Errorf("unexpected status code %d", apiErr.StatusCode)
+			}
+			if apiErr.Message != c.expected {
+				t.Errorf("expected message %q, got %q", c.expected, apiErr.Message)
+			}
+			if !strings.Contains(err.Error(), c.expected) {
+				t.Errorf("error %q does not contain %q", err.Error(), c.expected)
+			}
+		})
+	}
+}
+
+func TestNotFound(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		io.WriteString(w, `{"detail": "Not found."}`)
+	})
+
+	_, err := client.GetMultisigTransaction(context.Background(), testSafeTxHash)
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestRetriesOnRetryableStatus(t *testing.T) {
+	for _, status := range []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable} {
+		var attempts int32
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&attempts, 1) < 3 {
+				w.WriteHeader(status)
+				return
+			}
+			io.WriteString(w, `{"address": "`+testSafe.Hex()+`", "nonce": 4, "threshold": 2}`)
+		})
+
+		info, err := client.GetSafe(context.Background(), testSafe)
+		if err != nil {
+			t.Fatalf("status %d: unexpected error: %v", status, err)
+		}
+		if attempts != 3 {
+			t.Errorf("status %d: expected 3 attempts, got %d", status, attempts)
+		}
+		if info.Nonce != 4 {
+			t.Errorf("status %d: expected nonce 4, got %d", status, info.Nonce)
+		}
+	}
+}
+
+func TestRetriesGiveUp(t *testing.T) {
+	var attempts int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := client.GetSafe(context.Background(), testSafe)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("expected a 500 APIError, got %v", err)
+	}
+	if int(attempts) != DefaultMaxRetries+1 {
+		t.Errorf("expected %d attempts, got %d", DefaultMaxRetries+1, attempts)
+	}
+}
+
+func TestNoRetryOnClientError(t *testing.T) {
+	var attempts int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	if err := client.ProposeTransaction(context.Background(), testSafe, Proposal{}); err == nil {
+		t.Fatal("expected an error")
+	}
+	if attempts != 1 {
+		t.Errorf("expected 1 attempt, got %d", attempts)
+	}
+}
+
+func TestGetSafeNonceEncodings(t *testing.T) {
+	for _, nonce := range []string{`12`, `"12"`} {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/api/v1/safes/"+testSafe.Hex()+"/" {
+				t.Errorf("unexpected path %s", r.URL.Path)
+			}
+			io.WriteString(w, `{"address": "`+testSafe.Hex()+`", "nonce": `+nonce+`, "threshold": "2", "owners": ["0x01", "0x02"]}`)
+		})
+
+		info, err := client.GetSafe(context.Background(), testSafe)
+		if err != nil {
+			t.Fatalf("nonce %s: unexpected error: %v", nonce, err)
+		}
+		if info.Nonce != 12 || info.Threshold != 2 || len(info.Owners) != 2 {
+			t.Errorf("nonce %s: unexpected safe info %+v", nonce, info)
+		}
+	}
+}
+
+func TestListMultisigTransactionsPaginates(t *testing.T) {
+	var offsets []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("executed") != "false" || query.Get("nonce__gte") != "5" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		offsets = append(offsets, query.Get("offset"))
+
+		switch query.Get("offset") {
+		case "":
+			io.WriteString(w, `{"count": 3, "next": "http://example/?offset=2", "results": [{"nonce": 5}, {"nonce": "6"}]}`)
+		case "2":
+			io.WriteString(w, `{"count": 3, "next": null, "results": [{"nonce": 7}]}`)
+		default:
+			t.Errorf("unexpected offset %s", query.Get("offset"))
+		}
+	})
+
+	executed := false
+	nonceGte := uint64(5)
+	transactions, err := client.ListMultisigTransactions(context.Background(), testSafe, MultisigTransactionFilter{Executed: &executed, NonceGte: &nonceGte})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(transactions) != 3 {
+		t.Fatalf("expected 3 transactions, got %d", len(transactions))
+	}
+	for i, transaction := range transactions {
+		if transaction.Nonce != Uint64(5+i) {
+			t.Errorf("transaction %d: expected nonce %d, got %d", i, 5+i, transaction.Nonce)
+		}
+	}
+	if len(offsets) != 2 {
+		t.Errorf("expected 2 requests, got %d", len(offsets))
+	}
+}
+
+func TestConfirmations(t *testing.T) {
+	path := "/api/v1/multisig-transactions/" + testSafeTxHash.Hex() + "/confirmations/"
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != path {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		switch r.Method {
+		case http.MethodGet:
+			io.WriteString(w, `{"count": 1, "results": [{"owner": "0x01", "signature": "0xabcd"}]}`)
+		case http.MethodPost:
+			var body map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			if body["signature"] != "0xabcd" {
+				t.Errorf("unexpected signature %s", body["signature"])
+			}
+			w.WriteHeader(http.StatusCreated)
+		}
+	})
+
+	confirmations, err := client.GetConfirmations(context.Background(), testSafeTxHash)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(confirmations) != 1 || confirmations[0].Signature != "0xabcd" {
+		t.Errorf("unexpected confirmations %+v", confirmations)
+	}
+
+	if err := client.ConfirmTransaction(context.Background(), testSafeTxHash, []byte{0xab, 0xcd}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestDelegates(t *testing.T) {
+	delegate := common.HexToAddress("0x0000000000000000000000000000000000000002")
+	delegator := common.HexToAddress("0x0000000000000000000000000000000000000001")
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			if r.URL.Path != "/api/v2/delegates/" || r.URL.Query().Get("safe") != testSafe.Hex() {
+				t.Errorf("unexpected request %s", r.URL.String())
+			}
+			io.WriteString(w, `{"count": 1, "results": [{"delegate": "`+delegate.Hex()+`", "delegator": "`+delegator.Hex()+`", "label": "bot"}]}`)
+		case http.MethodPost:
+			var body map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			if body["delegate"] != delegate.Hex() || body["delegator"] != delegator.Hex() || body["safe"] != testSafe.Hex() || body["label"] != "bot" {
+				t.Errorf("unexpected body %v", body)
+			}
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodDelete:
+			if r.URL.Path != "/api/v2/delegates/"+delegate.Hex()+"/" {
+				t.Errorf("unexpected path %s", r.URL.Path)
+			}
+			var body map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			if _, ok := body["safe"]; ok {
+				t.Errorf("safe should not be sent when nil")
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	delegates, err := client.ListDelegates(context.Background(), testSafe)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(delegates) != 1 || delegates[0].Label != "bot" {
+		t.Errorf("unexpected delegates %+v", delegates)
+	}
+
+	if err := client.AddDelegate(context.Background(), &testSafe, delegate, delegator, "bot", []byte{0x01}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := client.RemoveDelegate(context.Background(), nil, delegate, delegator, []byte{0x01}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestContextCancelStopsRetries(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	client.Backoff = time.Hour
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := client.GetSafe(ctx, testSafe); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestServiceURL(t *testing.T) {
+	testCases := []struct {
+		api      string
+		expected string
+		fails    bool
+	}{
+		{"https://safe-transaction-mainnet.safe.global/", "https://safe-transaction-mainnet.safe.global", false},
+		{"http://localhost:8000", "http://localhost:8000", false},
+		{"https://safe-client.safe.global/v1/chains/1/transactions/0x3154Cf16ccdb4C6d922629664174b904d80F2C35/propose", DefaultURLs[1], false},
+		{"https://safe-client.safe.global/v1/chains/421614/transactions/0x3154Cf16ccdb4C6d922629664174b904d80F2C35/propose", DefaultURLs[421614], false},
+		{"https://safe-client.safe.global/v1/chains/13746/transactions/0x3154Cf16ccdb4C6d922629664174b904d80F2C35/propose", "", true},
+		{"safe-transaction-mainnet.safe.global", "", true},
+	}
+
+	for _, testCase := range testCases {
+		serviceURL, err := ServiceURL(testCase.api)
+		if testCase.fails {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %s", testCase.api, serviceURL)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", testCase.api, err)
+			continue
+		}
+		if serviceURL != testCase.expected {
+			t.Errorf("%s: expected %s, got %s", testCase.api, testCase.expected, serviceURL)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package txservice
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// DelegateTOTP returns the time based value the service expects in delegate signatures, which
+// changes every hour
+func DelegateTOTP(now time.Time) int64 {
+	return now.Unix() / 3600
+}
+
+// DelegateTypedDataHash returns the EIP-712 hash the delegator signs to add or remove a delegate
+// Source: https://github.com/safe-global/safe-transaction-service/blob/main/safe_transaction_service/history/helpers.py
+func DelegateTypedDataHash(chainID *big.Int, delegate common.Address, totp int64) (common.Hash, error) {
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": []apitypes.Type{
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+			},
+			"Delegate": []apitypes.Type{
+				{Name: "delegateAddress", Type: "address"},
+				{Name: "totp", Type: "uint256"},
+			},
+		},
+		Domain: apitypes.TypedDataDomain{
+			Name:    "Safe Transactions Service",
+			Version: "1.0",
+			ChainId: (*math.HexOrDecimal256)(chainID),
+		},
+		PrimaryType: "Delegate",
+		Message: apitypes.TypedDataMessage{
+			"delegateAddress": delegate.Hex(),
+			"totp":            fmt.Sprintf("%d", totp),
+		},
+	}
+
+	typedDataHash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to hash typed data: %v", err)
+	}
+
+	return common.BytesToHash(typedDataHash), nil
+}
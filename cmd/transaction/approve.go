@@ -0,0 +1,45 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/G7DAO/bifrost/bindings/ERC20"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// Approve sends an ERC20 approve of the amount to the spender and makes sure it is mined. The
+// transaction spending the allowance is simulated against the approval, so with --confirmations 0
+// it still waits for the approval to be mined. With --unsigned-out, the approval is written and an
+// error stops the command, as the next transaction cannot be built before the approval is sent.
+func Approve(ctx context.Context, client *ethclient.Client, key *keystore.Key, token common.Address, spender common.Address, amount *big.Int, flags *Flags) error {
+	erc20Abi, err := ERC20.ERC20MetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+
+	approveData, err := erc20Abi.Pack("approve", spender, amount)
+	if err != nil {
+		return err
+	}
+
+	tx, receipt, err := SendWithReceipt(ctx, client, key, token, big.NewInt(0), approveData, flags)
+	if err != nil {
+		return fmt.Errorf("failed to approve %s: %v", spender.Hex(), err)
+	}
+	if flags.Unsigned() {
+		return fmt.Errorf("the approval is written to %s, sign and broadcast it, then run the command again to write the transaction", flags.UnsignedOut)
+	}
+	fmt.Println("Approve transaction hash:", tx.Hash().Hex())
+
+	if receipt == nil {
+		if _, err := WaitMined(ctx, client, tx); err != nil {
+			return fmt.Errorf("approve failed: %v", err)
+		}
+	}
+
+	return nil
+}
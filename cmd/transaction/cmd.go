@@ -0,0 +1,276 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/G7DAO/bifrost/bindings/L1StandardBridge"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/cobra"
+)
+
+func CreateTxCommand() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:   "tx",
+		Short: "Manage transactions sent by bifrost",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	txCmd.AddCommand(CreateSpeedUpCommand())
+	txCmd.AddCommand(CreateCancelCommand())
+	txCmd.AddCommand(CreateSignCommand())
+	txCmd.AddCommand(CreateBroadcastCommand())
+
+	return txCmd
+}
+
+// replacementFlags are the flags shared by the commands replacing a pending transaction
+type replacementFlags struct {
+	keyFile, password, rpc string
+	txHash                 common.Hash
+	txFlags                Flags
+}
+
+func (f *replacementFlags) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.password, "password", "", "Password to decrypt the keyfile with")
+	cmd.Flags().StringVar(&f.keyFile, "keyfile", "", "Keyfile of the account that sent the pending transaction")
+	cmd.Flags().StringVar(&f.rpc, "rpc", "", "RPC URL of the chain the transaction is pending on")
+	f.txFlags.AddFlags(cmd)
+}
+
+func (f *replacementFlags) parse(args []string) error {
+	if len(args) != 1 || len(common.FromHex(args[0])) != common.HashLength {
+		return errors.New("the hash of the pending transaction is required")
+	}
+	f.txHash = common.HexToHash(args[0])
+
+	if f.rpc == "" {
+		return errors.New("rpc is required")
+	}
+
+	if f.keyFile == "" && !f.txFlags.Unsigned() {
+		return errors.New("keyfile is required")
+	}
+
+	return f.txFlags.Parse()
+}
+
+// replace signs and sends the replacement built by newReplacement for the pending transaction
+func (f *replacementFlags) replace(newReplacement func(ctx context.Context, client *ethclient.Client, from common.Address, pending *types.Transaction) (*types.Transaction, *Fees, error)) error {
+	key, err := f.txFlags.Key(f.keyFile, f.password)
+	if err != nil {
+		return err
+	}
+
+	client, err := ethclient.Dial(f.rpc)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	pending, err := GetPendingTransaction(ctx, client, key.Address, f.txHash)
+	if err != nil {
+		return err
+	}
+	fmt.Println("Replacing transaction", f.txHash.Hex(), "at nonce", pending.Nonce())
+
+	replacement, fees, err := newReplacement(ctx, client, key.Address, pending)
+	if err != nil {
+		return err
+	}
+
+	if f.txFlags.Unsigned() {
+		return WriteUnsigned(ctx, client, key.Address, replacement, fees, &f.txFlags)
+	}
+
+	signedReplacement, err := SignAndSend(ctx, client, key, replacement, fees, &f.txFlags)
+	if err != nil {
+		return err
+	}
+	fmt.Println("Transaction sent:", signedReplacement.Hash().Hex())
+
+	return nil
+}
+
+func CreateSpeedUpCommand() *cobra.Command {
+	flags := &replacementFlags{}
+
+	speedUpCmd := &cobra.Command{
+		Use:   "speedup <tx-hash>",
+		Short: "Resend a pending transaction with higher fees",
+		Long: `Resend a pending transaction with higher fees
+
+The pending transaction is replaced by a transaction with the same nonce, recipient, value, calldata
+and gas limit. Its fees are the current fees of --fee-strategy, raised to at least 10% above the
+fees of the pending transaction, the minimum bump nodes accept for a replacement.`,
+		Args: cobra.ExactArgs(1),
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return flags.parse(args)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return flags.replace(func(ctx context.Context, client *ethclient.Client, from common.Address, pending *types.Transaction) (*types.Transaction, *Fees, error) {
+				return NewSpeedUpTransaction(ctx, client, pending, &flags.txFlags)
+			})
+		},
+	}
+
+	flags.addFlags(speedUpCmd)
+
+	return speedUpCmd
+}
+
+func CreateCancelCommand() *cobra.Command {
+	flags := &replacementFlags{}
+
+	cancelCmd := &cobra.Command{
+		Use:   "cancel <tx-hash>",
+		Short: "Cancel a pending transaction",
+		Long: `Cancel a pending transaction
+
+The pending transaction is replaced by a 0-value transfer from the keyfile account to itself at the
+same nonce, with fees raised to at least 10% above the fees of the pending transaction. The pending
+transaction is cancelled once the transfer is mined.`,
+		Args: cobra.ExactArgs(1),
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return flags.parse(args)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return flags.replace(func(ctx context.Context, client *ethclient.Client, from common.Address, pending *types.Transaction) (*types.Transaction, *Fees, error) {
+				return NewCancelTransaction(ctx, client, from, pending, &flags.txFlags)
+			})
+		},
+	}
+
+	flags.addFlags(cancelCmd)
+
+	return cancelCmd
+}
+
+func CreateSignCommand() *cobra.Command {
+	var keyFile, password, out string
+
+	signCmd := &cobra.Command{
+		Use:   "sign <unsigned-tx.json>",
+		Short: "Sign a transaction written by --unsigned-out",
+		Long: `Sign a transaction written by --unsigned-out
+
+The transaction is signed offline, without an RPC, by the keyfile of its from account. The raw
+signed transaction is written to --out in hex, to be sent with tx broadcast.`,
+		Args: cobra.ExactArgs(1),
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if keyFile == "" {
+				return errors.New("keyfile is required")
+			}
+
+			if out == "" {
+				return errors.New("out is required")
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			unsigned, err := ReadUnsignedTransaction(args[0])
+			if err != nil {
+				return err
+			}
+
+			unsignedTransaction, err := unsigned.Transaction()
+			if err != nil {
+				return err
+			}
+			fmt.Println("Chain ID:", unsigned.ChainID.ToInt().String())
+			fmt.Println("From:", unsigned.From.Hex())
+			PrintSummary(unsignedTransaction, unsigned.Fees())
+
+			key, err := L1StandardBridge.KeyFromFile(keyFile, password)
+			if err != nil {
+				return err
+			}
+			if key.Address != unsigned.From {
+				return fmt.Errorf("keyfile account %s is not the from account %s of the transaction", key.Address.Hex(), unsigned.From.Hex())
+			}
+
+			signedTransaction, err := types.SignTx(unsignedTransaction, types.LatestSignerForChainID(unsigned.ChainID.ToInt()), key.PrivateKey)
+			if err != nil {
+				return err
+			}
+
+			if err := WriteSignedTransaction(out, signedTransaction); err != nil {
+				return err
+			}
+			fmt.Println("Signed transaction", signedTransaction.Hash().Hex(), "written to", out)
+
+			return nil
+		},
+	}
+
+	signCmd.Flags().StringVar(&password, "password", "", "Password to decrypt the keyfile with")
+	signCmd.Flags().StringVar(&keyFile, "keyfile", "", "Keyfile of the from account of the transaction")
+	signCmd.Flags().StringVar(&out, "out", "", "File to write the signed transaction to")
+
+	return signCmd
+}
+
+func CreateBroadcastCommand() *cobra.Command {
+	var rpc string
+	var confirmations uint64
+
+	broadcastCmd := &cobra.Command{
+		Use:   "broadcast <signed-tx>",
+		Short: "Send a transaction signed by tx sign",
+		Long: `Send a transaction signed by tx sign
+
+The raw signed transaction is sent to the RPC as it is. Unless --confirmations is 0, the command
+waits for it to be mined and fails with the decoded revert reason if it reverted.`,
+		Args: cobra.ExactArgs(1),
+
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if rpc == "" {
+				return errors.New("rpc is required")
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			signedTransaction, err := ReadSignedTransaction(args[0])
+			if err != nil {
+				return err
+			}
+
+			client, err := ethclient.Dial(rpc)
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			chainID, err := client.ChainID(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to fetch chain ID: %v", err)
+			}
+			if signedTransaction.Protected() && signedTransaction.ChainId().Cmp(chainID) != 0 {
+				return fmt.Errorf("transaction is signed for chain %s, the RPC serves chain %s", signedTransaction.ChainId().String(), chainID.String())
+			}
+
+			fmt.Println("Sending transaction", signedTransaction.Hash().Hex())
+			if _, err := Broadcast(ctx, client, signedTransaction, confirmations); err != nil {
+				return err
+			}
+			fmt.Println("Transaction sent:", signedTransaction.Hash().Hex())
+
+			return nil
+		},
+	}
+
+	broadcastCmd.Flags().StringVar(&rpc, "rpc", "", "RPC URL of the chain the transaction is signed for")
+	broadcastCmd.Flags().Uint64Var(&confirmations, "confirmations", DefaultConfirmations, "Number of confirmations to wait for before checking that the transaction succeeded, 0 to return once sent")
+
+	return broadcastCmd
+}
@@ -0,0 +1,151 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// FeeStrategy picks the priority fee among the priority fees paid in recent blocks
+type FeeStrategy string
+
+const (
+	FeeStrategySlow     FeeStrategy = "slow"
+	FeeStrategyStandard FeeStrategy = "standard"
+	FeeStrategyFast     FeeStrategy = "fast"
+)
+
+// FeeStrategyPercentiles are the eth_feeHistory reward percentiles of each strategy
+var FeeStrategyPercentiles = map[FeeStrategy]float64{
+	FeeStrategySlow:     10,
+	FeeStrategyStandard: 50,
+	FeeStrategyFast:     90,
+}
+
+// FeeStrategies lists the strategies in order, for flag descriptions and errors
+var FeeStrategies = []FeeStrategy{FeeStrategySlow, FeeStrategyStandard, FeeStrategyFast}
+
+// FeeHistoryBlocks is the number of recent blocks the priority fee is sampled from
+const FeeHistoryBlocks = 20
+
+// ParseFeeStrategy returns the strategy by name
+func ParseFeeStrategy(raw string) (FeeStrategy, error) {
+	strategy := FeeStrategy(raw)
+	if _, ok := FeeStrategyPercentiles[strategy]; !ok {
+		names := make([]string, len(FeeStrategies))
+		for i, name := range FeeStrategies {
+			names[i] = string(name)
+		}
+		return "", fmt.Errorf("invalid fee strategy %q, expected one of %s", raw, strings.Join(names, ", "))
+	}
+	return strategy, nil
+}
+
+// HistoricalPriorityFee returns the median over the non-empty blocks of the history of the priority
+// fee paid at the percentile of the strategy, or nil if every block of the history is empty. The
+// median keeps a single block of outliers from setting the fee.
+func HistoricalPriorityFee(history *ethereum.FeeHistory) *big.Int {
+	var rewards []*big.Int
+	for i, blockRewards := range history.Reward {
+		if i < len(history.GasUsedRatio) && history.GasUsedRatio[i] == 0 {
+			continue
+		}
+		if len(blockRewards) > 0 && blockRewards[0] != nil {
+			rewards = append(rewards, blockRewards[0])
+		}
+	}
+	if len(rewards) == 0 {
+		return nil
+	}
+
+	sort.Slice(rewards, func(i, j int) bool { return rewards[i].Cmp(rewards[j]) < 0 })
+	return new(big.Int).Set(rewards[len(rewards)/2])
+}
+
+// CheckFeeCap aborts when the fees are above the cap: a gas price or max fee per gas set with the
+// flags, or a base fee and priority fee that cannot be paid without going over the cap. Otherwise, the
+// max fee per gas is lowered to the cap, as the transaction can still be included at the current base
+// fee.
+func CheckFeeCap(fees *Fees, maxFeePerGasCap *big.Int, maxFeePerGasSet bool) error {
+	if maxFeePerGasCap == nil {
+		return nil
+	}
+
+	if fees.IsLegacy() {
+		if fees.GasPrice.Cmp(maxFeePerGasCap) > 0 {
+			return fmt.Errorf("gas price of %s gwei is above --max-fee-per-gas-cap of %s gwei, aborting", FormatGwei(fees.GasPrice), FormatGwei(maxFeePerGasCap))
+		}
+		return nil
+	}
+
+	if maxFeePerGasSet && fees.MaxFeePerGas.Cmp(maxFeePerGasCap) > 0 {
+		return fmt.Errorf("--max-fee-per-gas of %s gwei is above --max-fee-per-gas-cap of %s gwei", FormatGwei(fees.MaxFeePerGas), FormatGwei(maxFeePerGasCap))
+	}
+
+	minimumFee := new(big.Int).Add(fees.BaseFee, fees.MaxPriorityFeePerGas)
+	if minimumFee.Cmp(maxFeePerGasCap) > 0 {
+		return fmt.Errorf("base fee of %s gwei plus priority fee of %s gwei is above --max-fee-per-gas-cap of %s gwei, aborting", FormatGwei(fees.BaseFee), FormatGwei(fees.MaxPriorityFeePerGas), FormatGwei(maxFeePerGasCap))
+	}
+
+	if fees.MaxFeePerGas.Cmp(maxFeePerGasCap) > 0 {
+		fees.MaxFeePerGas = new(big.Int).Set(maxFeePerGasCap)
+	}
+	return nil
+}
+
+// FormatGwei formats an amount of wei in gwei
+func FormatGwei(wei *big.Int) string {
+	gwei := new(big.Float).SetPrec(256).Quo(new(big.Float).SetPrec(256).SetInt(wei), big.NewFloat(params.GWei))
+	formatted := gwei.Text('f', 9)
+	formatted = strings.TrimRight(formatted, "0")
+	return strings.TrimSuffix(formatted, ".")
+}
+
+// ParseGwei parses a decimal amount of gwei, with up to 9 decimals, into wei
+func ParseGwei(raw string) (*big.Int, error) {
+	whole, fraction, _ := strings.Cut(raw, ".")
+	if whole == "" || len(fraction) > 9 || strings.ContainsAny(whole+fraction, "+-") {
+		return nil, fmt.Errorf("%q is not a valid amount of gwei", raw)
+	}
+	wei, ok := new(big.Int).SetString(whole+fraction+strings.Repeat("0", 9-len(fraction)), 10)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a valid amount of gwei", raw)
+	}
+	return wei, nil
+}
+
+// suggestPriorityFee returns the priority fee of the strategy from eth_feeHistory, along with the base
+// fee of the next block. It falls back to the tip suggested by the RPC and the base fee of the latest
+// block when the RPC does not serve the fee history.
+func suggestPriorityFee(ctx context.Context, client *ethclient.Client, strategy FeeStrategy, latestBaseFee *big.Int) (*big.Int, *big.Int, error) {
+	history, err := client.FeeHistory(ctx, FeeHistoryBlocks, nil, []float64{FeeStrategyPercentiles[strategy]})
+	if err != nil {
+		fmt.Println("Warning: eth_feeHistory failed, using the priority fee suggested by the RPC:", err)
+		tip, tipErr := client.SuggestGasTipCap(ctx)
+		if tipErr != nil {
+			return nil, nil, fmt.Errorf("failed to fetch priority fee: %v", tipErr)
+		}
+		return tip, latestBaseFee, nil
+	}
+
+	baseFee := latestBaseFee
+	if len(history.BaseFee) > 0 && history.BaseFee[len(history.BaseFee)-1] != nil {
+		baseFee = history.BaseFee[len(history.BaseFee)-1]
+	}
+
+	tip := HistoricalPriorityFee(history)
+	if tip == nil {
+		tip, err = client.SuggestGasTipCap(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to fetch priority fee: %v", err)
+		}
+	}
+
+	return tip, baseFee, nil
+}
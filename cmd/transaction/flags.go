@@ -0,0 +1,158 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/G7DAO/bifrost/bindings/L1StandardBridge"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/cobra"
+)
+
+// DefaultConfirmations waits for the transaction to be mined, so that its receipt is checked
+const DefaultConfirmations = 1
+
+// Flags holds the flags shared by every command that sends a transaction from the keyfile account
+type Flags struct {
+	GasLimit                uint64
+	MaxFeePerGasRaw         string
+	MaxPriorityFeePerGasRaw string
+	MaxFeePerGasCapRaw      []string
+	FeeStrategyRaw          string
+	Confirmations           uint64
+	UnsignedOut             string
+	FromRaw                 string
+
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	MaxFeePerGasCaps     map[uint64]*big.Int
+	FeeStrategy          FeeStrategy
+	From                 common.Address
+}
+
+// AddFlags registers the --gas-limit, --max-fee-per-gas, --max-priority-fee-per-gas,
+// --max-fee-per-gas-cap, --fee-strategy, --confirmations, --unsigned-out and --from flags on the
+// command
+func (f *Flags) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64Var(&f.GasLimit, "gas-limit", 0, "Gas limit of the transaction (optional, estimated by default)")
+	cmd.Flags().StringVar(&f.MaxFeePerGasRaw, "max-fee-per-gas", "", "Maximum fee per gas in wei, or gas price of legacy transactions (optional, defaults to twice the base fee plus the priority fee)")
+	cmd.Flags().StringVar(&f.MaxPriorityFeePerGasRaw, "max-priority-fee-per-gas", "", "Maximum priority fee per gas in wei (optional, defaults to the priority fee of --fee-strategy)")
+	cmd.Flags().StringArrayVar(&f.MaxFeePerGasCapRaw, "max-fee-per-gas-cap", nil, "Hard ceiling on the fee per gas of a chain as <chainID>=<gwei>, aborting if the current fees on that chain exceed it. Repeat it for each chain the command sends a transaction to (optional)")
+	cmd.Flags().StringVar(&f.FeeStrategyRaw, "fee-strategy", string(FeeStrategyStandard), "Priority fee of recent blocks to pay: slow (10th percentile), standard (median) or fast (90th percentile)")
+	cmd.Flags().Uint64Var(&f.Confirmations, "confirmations", DefaultConfirmations, "Number of confirmations to wait for before checking that the transaction succeeded, 0 to return once sent")
+	cmd.Flags().StringVar(&f.UnsignedOut, "unsigned-out", "", "File to write the unsigned transaction to, to sign it elsewhere with tx sign, instead of signing and sending it (optional, no keyfile is loaded)")
+	cmd.Flags().StringVar(&f.FromRaw, "from", "", "Address the unsigned transaction is sent from, required with --unsigned-out")
+}
+
+// Parse validates the transaction flags
+func (f *Flags) Parse() error {
+	if f.MaxFeePerGasRaw != "" {
+		f.MaxFeePerGas = new(big.Int)
+		if _, ok := f.MaxFeePerGas.SetString(f.MaxFeePerGasRaw, 10); !ok || f.MaxFeePerGas.Sign() <= 0 {
+			return errors.New("--max-fee-per-gas is not a valid amount of wei")
+		}
+	}
+
+	if f.MaxPriorityFeePerGasRaw != "" {
+		f.MaxPriorityFeePerGas = new(big.Int)
+		if _, ok := f.MaxPriorityFeePerGas.SetString(f.MaxPriorityFeePerGasRaw, 10); !ok || f.MaxPriorityFeePerGas.Sign() < 0 {
+			return errors.New("--max-priority-fee-per-gas is not a valid amount of wei")
+		}
+	}
+
+	if len(f.MaxFeePerGasCapRaw) > 0 {
+		caps, err := ParseFeeCaps(f.MaxFeePerGasCapRaw)
+		if err != nil {
+			return err
+		}
+		f.MaxFeePerGasCaps = caps
+	}
+
+	f.FeeStrategy = FeeStrategyStandard
+	if f.FeeStrategyRaw != "" {
+		strategy, err := ParseFeeStrategy(f.FeeStrategyRaw)
+		if err != nil {
+			return err
+		}
+		f.FeeStrategy = strategy
+	}
+
+	if f.MaxFeePerGas != nil && f.MaxPriorityFeePerGas != nil && f.MaxFeePerGas.Cmp(f.MaxPriorityFeePerGas) < 0 {
+		return errors.New("--max-fee-per-gas cannot be lower than --max-priority-fee-per-gas")
+	}
+
+	if f.UnsignedOut != "" {
+		if !common.IsHexAddress(f.FromRaw) {
+			return errors.New("--from must be a valid address with --unsigned-out")
+		}
+		f.From = common.HexToAddress(f.FromRaw)
+	}
+
+	return nil
+}
+
+// ParseFeeCaps parses the --max-fee-per-gas-cap values, each <chainID>=<gwei>, into the caps in wei
+// by chain ID
+func ParseFeeCaps(values []string) (map[uint64]*big.Int, error) {
+	caps := make(map[uint64]*big.Int, len(values))
+	for _, value := range values {
+		rawChainID, rawCap, ok := strings.Cut(value, "=")
+		if !ok {
+			return nil, fmt.Errorf("--max-fee-per-gas-cap %q is not <chainID>=<gwei>", value)
+		}
+		chainID, err := strconv.ParseUint(strings.TrimSpace(rawChainID), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("--max-fee-per-gas-cap %q does not start with a valid chain ID", value)
+		}
+		if _, ok := caps[chainID]; ok {
+			return nil, fmt.Errorf("--max-fee-per-gas-cap is set twice for chain %d", chainID)
+		}
+		maxFee, err := ParseGwei(strings.TrimSpace(rawCap))
+		if err != nil || maxFee.Sign() <= 0 {
+			return nil, fmt.Errorf("--max-fee-per-gas-cap %q is not a valid amount of gwei", value)
+		}
+		caps[chainID] = maxFee
+	}
+	return caps, nil
+}
+
+// MaxFeePerGasCap returns the --max-fee-per-gas-cap of the chain, or nil if it has none
+func (f *Flags) MaxFeePerGasCap(chainID *big.Int) *big.Int {
+	if f == nil || chainID == nil || !chainID.IsUint64() {
+		return nil
+	}
+	return f.MaxFeePerGasCaps[chainID.Uint64()]
+}
+
+// maxFeePerGasCap returns the --max-fee-per-gas-cap of the chain of the client, without fetching its
+// chain ID when no cap is set
+func (f *Flags) maxFeePerGasCap(ctx context.Context, client *ethclient.Client) (*big.Int, error) {
+	if f == nil || len(f.MaxFeePerGasCaps) == 0 {
+		return nil, nil
+	}
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch chain ID: %v", err)
+	}
+	return f.MaxFeePerGasCap(chainID), nil
+}
+
+// Unsigned returns true if the transaction is written to --unsigned-out instead of being sent
+func (f *Flags) Unsigned() bool {
+	return f != nil && f.UnsignedOut != ""
+}
+
+// Key decrypts the keyfile the transaction is signed with. With --unsigned-out, the keyfile is not
+// loaded and the key only holds the --from address.
+func (f *Flags) Key(keyFile string, password string) (*keystore.Key, error) {
+	if f.Unsigned() {
+		return &keystore.Key{Address: f.From}, nil
+	}
+	return L1StandardBridge.KeyFromFile(keyFile, password)
+}
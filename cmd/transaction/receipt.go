@@ -0,0 +1,110 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/G7DAO/bifrost/cmd/decode"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// WaitMined waits for the transaction to be mined and checks that it succeeded
+func WaitMined(ctx context.Context, client *ethclient.Client, transaction *types.Transaction) (*types.Receipt, error) {
+	receipt, err := WaitForReceipt(ctx, client, transaction, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckReceipt(ctx, client, transaction, receipt); err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+// CheckReceipt returns nil if the transaction succeeded. If it reverted, the transaction is replayed
+// with eth_call at the block it was mined in, and the returned error carries the revert reason
+// decoded against the ABIs of bindings/.
+func CheckReceipt(ctx context.Context, client *ethclient.Client, transaction *types.Transaction, receipt *types.Receipt) error {
+	if receipt.Status == types.ReceiptStatusSuccessful {
+		return nil
+	}
+
+	reason, err := RevertReason(ctx, client, transaction, receipt)
+	if err != nil {
+		return fmt.Errorf("transaction %s reverted in block %s, failed to replay it: %v", transaction.Hash().Hex(), receipt.BlockNumber.String(), err)
+	}
+	return fmt.Errorf("transaction %s reverted in block %s: %s", transaction.Hash().Hex(), receipt.BlockNumber.String(), reason)
+}
+
+// RevertReason replays the reverted transaction with eth_call at the block it was mined in and
+// decodes the revert data: a reason string, a panic code, or a custom error of the bindings
+func RevertReason(ctx context.Context, client *ethclient.Client, transaction *types.Transaction, receipt *types.Receipt) (string, error) {
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch chain ID: %v", err)
+	}
+	from, err := types.Sender(types.LatestSignerForChainID(chainID), transaction)
+	if err != nil {
+		return "", fmt.Errorf("failed to recover the sender: %v", err)
+	}
+
+	callMsg := ethereum.CallMsg{
+		From:  from,
+		To:    transaction.To(),
+		Gas:   transaction.Gas(),
+		Value: transaction.Value(),
+		Data:  transaction.Data(),
+	}
+	_, callErr := client.CallContract(ctx, callMsg, receipt.BlockNumber)
+	if callErr == nil {
+		if receipt.GasUsed >= transaction.Gas() {
+			return fmt.Sprintf("out of gas, all %d gas was used", receipt.GasUsed), nil
+		}
+		return "the replay did not revert, the revert depends on the position of the transaction in its block", nil
+	}
+
+	return DecodeCallError(callErr)
+}
+
+// DecodeCallError decodes the revert data carried by the error of an eth_call or eth_estimateGas
+// against the ABIs of bindings/. Errors without revert data are returned as they are.
+func DecodeCallError(callErr error) (string, error) {
+	var dataErr rpc.DataError
+	if !errors.As(callErr, &dataErr) {
+		return callErr.Error(), nil
+	}
+	revertData, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return callErr.Error(), nil
+	}
+	data, err := hexutil.Decode(revertData)
+	if err != nil {
+		return callErr.Error(), nil
+	}
+
+	registry, err := errorRegistry()
+	if err != nil {
+		return "", err
+	}
+	return registry.DecodeRevert(data)
+}
+
+var (
+	bindingsRegistry     *decode.Registry
+	bindingsRegistryErr  error
+	bindingsRegistryOnce sync.Once
+)
+
+// errorRegistry returns the registry of the ABIs of bindings/, parsed once
+func errorRegistry() (*decode.Registry, error) {
+	bindingsRegistryOnce.Do(func() {
+		bindingsRegistry, bindingsRegistryErr = decode.NewBindingsRegistry()
+	})
+	return bindingsRegistry, bindingsRegistryErr
+}
@@ -0,0 +1,159 @@
+package transaction
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// newRevertTestClient serves eth_call as a revert with the given data, or as a success if revertData
+// is nil
+func newRevertTestClient(t *testing.T, revertData []byte) *ethclient.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Fatal(err)
+		}
+
+		var result interface{}
+		switch request.Method {
+		case "eth_chainId":
+			result = (*hexutil.Big)(big.NewInt(1))
+		case "eth_sendRawTransaction":
+			result = common.Hash{}
+		case "eth_call":
+			if revertData != nil {
+				json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": request.ID, "error": map[string]interface{}{"code": 3, "message": "execution reverted", "data": hexutil.Encode(revertData)}})
+				return
+			}
+			result = "0x"
+		default:
+			t.Errorf("unexpected method %s", request.Method)
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": request.ID, "result": result})
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := ethclient.Dial(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func newSignedTestTransaction(t *testing.T) *types.Transaction {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	to := common.HexToAddress("0x2Fc99fd16D8D3F6F66d164aA84E244c567E58A3d")
+	transaction, err := types.SignNewTx(key, types.LatestSignerForChainID(big.NewInt(1)), &types.DynamicFeeTx{
+		ChainID:   big.NewInt(1),
+		Nonce:     7,
+		GasTipCap: big.NewInt(1_000_000_000),
+		GasFeeCap: big.NewInt(30_000_000_000),
+		Gas:       100_000,
+		To:        &to,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return transaction
+}
+
+func TestCheckReceipt(t *testing.T) {
+	stringType, err := abi.NewType("string", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reason, err := abi.Arguments{{Type: stringType}}.Pack("ERC20: insufficient allowance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	origin := common.HexToAddress("0x3154Cf16ccdb4C6d922629664174b904d80F2C35")
+
+	testCases := []struct {
+		name       string
+		revertData []byte
+		gasUsed    uint64
+		expected   string
+	}{
+		{"reason", append(common.FromHex("0x08c379a0"), reason...), 50_000, "ERC20: insufficient allowance"},
+		{"custom error", append(crypto.Keccak256([]byte("NotAllowedOrigin(address)"))[:4], common.LeftPadBytes(origin.Bytes(), 32)...), 50_000, "NotAllowedOrigin(origin: " + origin.Hex() + ")"},
+		{"panic", append(common.FromHex("0x4e487b71"), common.LeftPadBytes([]byte{0x11}, 32)...), 50_000, "panic: "},
+		{"out of gas", nil, 100_000, "out of gas"},
+	}
+
+	transaction := newSignedTestTransaction(t)
+	for _, testCase := range testCases {
+		client := newRevertTestClient(t, testCase.revertData)
+		receipt := &types.Receipt{Status: types.ReceiptStatusFailed, BlockNumber: big.NewInt(100), GasUsed: testCase.gasUsed}
+
+		err := CheckReceipt(context.Background(), client, transaction, receipt)
+		if err == nil {
+			t.Errorf("%s: expected an error on a reverted transaction", testCase.name)
+			continue
+		}
+		if !strings.Contains(err.Error(), testCase.expected) {
+			t.Errorf("%s: expected the error to contain %q, got %q", testCase.name, testCase.expected, err.Error())
+		}
+	}
+
+	receipt := &types.Receipt{Status: types.ReceiptStatusSuccessful, BlockNumber: big.NewInt(100)}
+	if err := CheckReceipt(context.Background(), nil, transaction, receipt); err != nil {
+		t.Errorf("expected no error on a successful transaction, got %v", err)
+	}
+}
+
+func TestSimulate(t *testing.T) {
+	from := common.HexToAddress("0x3154Cf16ccdb4C6d922629664174b904d80F2C35")
+	to := common.HexToAddress("0x2Fc99fd16D8D3F6F66d164aA84E244c567E58A3d")
+
+	if err := Simulate(context.Background(), newRevertTestClient(t, nil), from, to, big.NewInt(0), nil, 0); err != nil {
+		t.Errorf("expected no error on a successful simulation, got %v", err)
+	}
+
+	stringType, err := abi.NewType("string", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reason, err := abi.Arguments{{Type: stringType}}.Pack("Burn amount exceeds per tx limit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = Simulate(context.Background(), newRevertTestClient(t, append(common.FromHex("0x08c379a0"), reason...)), from, to, big.NewInt(0), nil, 0)
+	if err == nil || !strings.Contains(err.Error(), "Burn amount exceeds per tx limit") {
+		t.Errorf("expected the decoded revert reason, got %v", err)
+	}
+
+	err = Simulate(context.Background(), newRevertTestClient(t, common.FromHex("0xdeadbeef")), from, to, big.NewInt(0), nil, 0)
+	if err == nil || !strings.Contains(err.Error(), "execution reverted") {
+		t.Errorf("expected the raw error on an unknown selector, got %v", err)
+	}
+}
+
+func TestBroadcastWithoutConfirmations(t *testing.T) {
+	// Any receipt lookup would hit an unexpected method, so 0 confirmations must return once sent
+	receipt, err := Broadcast(context.Background(), newRevertTestClient(t, nil), newSignedTestTransaction(t), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if receipt != nil {
+		t.Errorf("expected no receipt without confirmations, got %+v", receipt)
+	}
+}
@@ -0,0 +1,187 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// ReplacementFeeBump is the minimum increase, in percent, of the fees of a transaction replacing a
+// pending one. Nodes drop replacements with a smaller bump of the priority fee or max fee per gas.
+const ReplacementFeeBump = 10
+
+// BumpFee returns the fee increased by ReplacementFeeBump percent, rounded up
+func BumpFee(fee *big.Int) *big.Int {
+	bumped := new(big.Int).Mul(fee, big.NewInt(100+ReplacementFeeBump))
+	bumped.Add(bumped, big.NewInt(99))
+	return bumped.Div(bumped, big.NewInt(100))
+}
+
+// GetPendingTransaction returns the pending transaction of the account, failing if it was mined or
+// replaced, or if it was sent by another account
+func GetPendingTransaction(ctx context.Context, client *ethclient.Client, from common.Address, txHash common.Hash) (*types.Transaction, error) {
+	transaction, isPending, err := client.TransactionByHash(ctx, txHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch transaction %s: %v", txHash.Hex(), err)
+	}
+	if !isPending {
+		return nil, fmt.Errorf("transaction %s is already mined", txHash.Hex())
+	}
+
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch chain ID: %v", err)
+	}
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), transaction)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recover the sender of %s: %v", txHash.Hex(), err)
+	}
+	if sender != from {
+		return nil, fmt.Errorf("transaction %s was sent by %s, not by the keyfile account %s", txHash.Hex(), sender.Hex(), from.Hex())
+	}
+
+	nonce, err := client.NonceAt(ctx, from, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch nonce of %s: %v", from.Hex(), err)
+	}
+	if transaction.Nonce() < nonce {
+		return nil, fmt.Errorf("nonce %d of transaction %s was already used by a mined transaction", transaction.Nonce(), txHash.Hex())
+	}
+
+	return transaction, nil
+}
+
+// ReplacementFees returns the fees of a transaction replacing the pending one: the current fees, raised
+// to the fees of the pending transaction bumped by ReplacementFeeBump percent. It fails if the fees set
+// with the flags are below that minimum, or if the minimum is above the --max-fee-per-gas-cap of the
+// chain.
+func ReplacementFees(ctx context.Context, client *ethclient.Client, pending *types.Transaction, flags *Flags) (*Fees, error) {
+	if flags == nil {
+		flags = &Flags{}
+	}
+
+	maxFeePerGasCap, err := flags.maxFeePerGasCap(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	fees, err := SuggestFees(ctx, client, &Flags{MaxFeePerGas: flags.MaxFeePerGas, MaxPriorityFeePerGas: flags.MaxPriorityFeePerGas, FeeStrategy: flags.FeeStrategy})
+	if err != nil {
+		return nil, err
+	}
+	fees.MaxFeePerGasCap = maxFeePerGasCap
+
+	if pending.Type() == types.LegacyTxType {
+		gasPrice := fees.GasPrice
+		if gasPrice == nil {
+			gasPrice = fees.MaxFeePerGas
+		}
+
+		minimumGasPrice := BumpFee(pending.GasPrice())
+		if flags.MaxFeePerGas != nil && gasPrice.Cmp(minimumGasPrice) < 0 {
+			return nil, fmt.Errorf("--max-fee-per-gas is below the replacement minimum of %s gwei", FormatGwei(minimumGasPrice))
+		}
+		fees = &Fees{Strategy: fees.Strategy, GasPrice: bigMax(gasPrice, minimumGasPrice), MaxFeePerGasCap: maxFeePerGasCap}
+	} else {
+		if fees.IsLegacy() {
+			fees = &Fees{Strategy: fees.Strategy, BaseFee: big.NewInt(0), MaxFeePerGas: fees.GasPrice, MaxPriorityFeePerGas: fees.GasPrice, MaxFeePerGasCap: maxFeePerGasCap}
+		}
+
+		minimumTip := BumpFee(pending.GasTipCap())
+		if flags.MaxPriorityFeePerGas != nil && fees.MaxPriorityFeePerGas.Cmp(minimumTip) < 0 {
+			return nil, fmt.Errorf("--max-priority-fee-per-gas is below the replacement minimum of %s gwei", FormatGwei(minimumTip))
+		}
+		minimumMaxFee := BumpFee(pending.GasFeeCap())
+		if flags.MaxFeePerGas != nil && fees.MaxFeePerGas.Cmp(minimumMaxFee) < 0 {
+			return nil, fmt.Errorf("--max-fee-per-gas is below the replacement minimum of %s gwei", FormatGwei(minimumMaxFee))
+		}
+
+		fees.MaxPriorityFeePerGas = bigMax(fees.MaxPriorityFeePerGas, minimumTip)
+		fees.MaxFeePerGas = bigMax(fees.MaxFeePerGas, minimumMaxFee)
+		if fees.MaxFeePerGas.Cmp(fees.MaxPriorityFeePerGas) < 0 {
+			fees.MaxFeePerGas = new(big.Int).Set(fees.MaxPriorityFeePerGas)
+		}
+	}
+
+	if err := CheckFeeCap(fees, maxFeePerGasCap, flags.MaxFeePerGas != nil); err != nil {
+		return nil, err
+	}
+	// Lowering the max fee per gas to the cap must keep the bump the replacement needs
+	if !fees.IsLegacy() && pending.Type() != types.LegacyTxType {
+		if minimumMaxFee := BumpFee(pending.GasFeeCap()); fees.MaxFeePerGas.Cmp(minimumMaxFee) < 0 {
+			return nil, fmt.Errorf("replacement max fee per gas of %s gwei is above --max-fee-per-gas-cap of %s gwei, aborting", FormatGwei(minimumMaxFee), FormatGwei(maxFeePerGasCap))
+		}
+	}
+
+	return fees, nil
+}
+
+// NewReplacementTransaction builds the unsigned transaction replacing the pending one at its nonce,
+// with the fees of ReplacementFees. The gas limit is --gas-limit, or gasLimit.
+func NewReplacementTransaction(ctx context.Context, client *ethclient.Client, pending *types.Transaction, to common.Address, value *big.Int, data []byte, gasLimit uint64, flags *Flags) (*types.Transaction, *Fees, error) {
+	if flags == nil {
+		flags = &Flags{}
+	}
+	if flags.GasLimit != 0 {
+		gasLimit = flags.GasLimit
+	}
+
+	fees, err := ReplacementFees(ctx, client, pending, flags)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if fees.IsLegacy() {
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    pending.Nonce(),
+			GasPrice: fees.GasPrice,
+			Gas:      gasLimit,
+			To:       &to,
+			Value:    value,
+			Data:     data,
+		}), fees, nil
+	}
+
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch chain ID: %v", err)
+	}
+
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     pending.Nonce(),
+		GasTipCap: fees.MaxPriorityFeePerGas,
+		GasFeeCap: fees.MaxFeePerGas,
+		Gas:       gasLimit,
+		To:        &to,
+		Value:     value,
+		Data:      data,
+	}), fees, nil
+}
+
+// NewSpeedUpTransaction builds the unsigned transaction resending the payload of the pending one with
+// bumped fees
+func NewSpeedUpTransaction(ctx context.Context, client *ethclient.Client, pending *types.Transaction, flags *Flags) (*types.Transaction, *Fees, error) {
+	if pending.To() == nil {
+		return nil, nil, fmt.Errorf("transaction %s deploys a contract, which cannot be sped up", pending.Hash().Hex())
+	}
+
+	return NewReplacementTransaction(ctx, client, pending, *pending.To(), pending.Value(), pending.Data(), pending.Gas(), flags)
+}
+
+// NewCancelTransaction builds the unsigned 0-value transfer to the account itself with bumped fees,
+// which takes the nonce of the pending transaction
+func NewCancelTransaction(ctx context.Context, client *ethclient.Client, from common.Address, pending *types.Transaction, flags *Flags) (*types.Transaction, *Fees, error) {
+	return NewReplacementTransaction(ctx, client, pending, from, big.NewInt(0), nil, params.TxGas, flags)
+}
+
+func bigMax(a *big.Int, b *big.Int) *big.Int {
+	if a.Cmp(b) >= 0 {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,99 @@
+package transaction
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func TestBumpFee(t *testing.T) {
+	testCases := map[int64]int64{
+		0:             0,
+		1:             2,
+		10:            11,
+		1_000_000_000: 1_100_000_000,
+		1_000_000_001: 1_100_000_002,
+	}
+	for fee, expected := range testCases {
+		if bumped := BumpFee(big.NewInt(fee)); bumped.Int64() != expected {
+			t.Errorf("BumpFee(%d): expected %d, got %s", fee, expected, bumped.String())
+		}
+	}
+}
+
+func TestReplacementFees(t *testing.T) {
+	client := newTestClient(t, big.NewInt(10_000_000_000), 12_000_000_000, 1_000_000_000, true)
+	pending := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   big.NewInt(1),
+		Nonce:     7,
+		GasTipCap: big.NewInt(1_000_000_000),
+		GasFeeCap: big.NewInt(30_000_000_000),
+		Gas:       100_000,
+	})
+
+	// The current priority fee of 2 gwei is above the bump of 1.1 gwei, the current max fee per gas of
+	// 24 gwei is below the bump of 33 gwei
+	fees, err := ReplacementFees(context.Background(), client, pending, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fees.MaxPriorityFeePerGas.Int64() != 2_000_000_000 || fees.MaxFeePerGas.Int64() != 33_000_000_000 {
+		t.Errorf("unexpected replacement fees %+v", fees)
+	}
+
+	flags := &Flags{MaxFeePerGasCapRaw: []string{"1=30"}}
+	if err := flags.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReplacementFees(context.Background(), client, pending, flags); err == nil {
+		t.Error("expected an error on a bump above the cap")
+	}
+
+	flags = &Flags{MaxPriorityFeePerGasRaw: "1050000000"}
+	if err := flags.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReplacementFees(context.Background(), client, pending, flags); err == nil {
+		t.Error("expected an error on a priority fee below the replacement minimum")
+	}
+}
+
+func TestReplacementFeesLegacy(t *testing.T) {
+	client := newTestClient(t, nil, 12_000_000_000, 1_000_000_000, false)
+	pending := types.NewTx(&types.LegacyTx{Nonce: 7, GasPrice: big.NewInt(20_000_000_000), Gas: 100_000})
+
+	fees, err := ReplacementFees(context.Background(), client, pending, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !fees.IsLegacy() || fees.GasPrice.Int64() != 22_000_000_000 {
+		t.Errorf("expected a legacy gas price of 22 gwei, got %+v", fees)
+	}
+}
+
+func TestNewCancelTransaction(t *testing.T) {
+	client := newTestClient(t, nil, 12_000_000_000, 1_000_000_000, false)
+	from := common.HexToAddress("0x3154Cf16ccdb4C6d922629664174b904d80F2C35")
+	to := common.HexToAddress("0x2Fc99fd16D8D3F6F66d164aA84E244c567E58A3d")
+	pending := types.NewTx(&types.LegacyTx{Nonce: 7, GasPrice: big.NewInt(20_000_000_000), Gas: 100_000, To: &to, Value: big.NewInt(5), Data: []byte{0x01}})
+
+	cancel, _, err := NewCancelTransaction(context.Background(), client, from, pending, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cancel.Nonce() != 7 || *cancel.To() != from || cancel.Value().Sign() != 0 || len(cancel.Data()) != 0 || cancel.Gas() != params.TxGas {
+		t.Errorf("unexpected cancel transaction to %s, nonce %d, value %s, gas %d", cancel.To().Hex(), cancel.Nonce(), cancel.Value().String(), cancel.Gas())
+	}
+
+	speedUp, _, err := NewSpeedUpTransaction(context.Background(), client, pending, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if speedUp.Nonce() != 7 || *speedUp.To() != to || speedUp.Value().Int64() != 5 || len(speedUp.Data()) != 1 || speedUp.Gas() != 100_000 {
+		t.Errorf("unexpected speed up transaction to %s, nonce %d, value %s, gas %d", speedUp.To().Hex(), speedUp.Nonce(), speedUp.Value().String(), speedUp.Gas())
+	}
+}
@@ -0,0 +1,34 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// Simulate runs the transaction with eth_call from the sender against the latest block. If it
+// reverts, the returned error carries the revert reason or custom error decoded against the ABIs of
+// bindings/, instead of the bare "execution reverted" of eth_estimateGas.
+func Simulate(ctx context.Context, client *ethclient.Client, from common.Address, to common.Address, value *big.Int, data []byte, gasLimit uint64) error {
+	callMsg := ethereum.CallMsg{
+		From:  from,
+		To:    &to,
+		Gas:   gasLimit,
+		Value: value,
+		Data:  data,
+	}
+	_, callErr := client.CallContract(ctx, callMsg, nil)
+	if callErr == nil {
+		return nil
+	}
+
+	reason, err := DecodeCallError(callErr)
+	if err != nil {
+		return fmt.Errorf("simulation of the transaction to %s reverted: %v", to.Hex(), callErr)
+	}
+	return fmt.Errorf("simulation of the transaction to %s reverted: %s", to.Hex(), reason)
+}
@@ -0,0 +1,325 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// BaseFeeMultiplier is the headroom of the default max fee per gas over the base fee of the next
+// block. It keeps the transaction includable through six consecutive full blocks, each raising the
+// base fee by 12.5%.
+const BaseFeeMultiplier = 2
+
+// ConfirmationPollInterval is the interval between checks for new blocks while waiting for
+// confirmations
+const ConfirmationPollInterval = 2 * time.Second
+
+// Fees are the fees of a transaction. GasPrice is only set for legacy transactions, on chains without
+// EIP-1559.
+type Fees struct {
+	Strategy             FeeStrategy
+	BaseFee              *big.Int
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	MaxFeePerGasCap      *big.Int
+	GasPrice             *big.Int
+}
+
+// IsLegacy returns true if the fees are for a legacy transaction
+func (f *Fees) IsLegacy() bool {
+	return f.GasPrice != nil
+}
+
+// SuggestFees returns the fees of a transaction sent now: the priority fee of the --fee-strategy
+// percentile over recent blocks, and a max fee per gas of twice the base fee of the next block plus
+// the priority fee, unless overridden by the flags. It falls back to a legacy gas price if the latest
+// block has no base fee, and fails if the fees are above the --max-fee-per-gas-cap of the chain.
+func SuggestFees(ctx context.Context, client *ethclient.Client, flags *Flags) (*Fees, error) {
+	if flags == nil {
+		flags = &Flags{}
+	}
+	strategy := flags.FeeStrategy
+	if strategy == "" {
+		strategy = FeeStrategyStandard
+	}
+
+	maxFeePerGasCap, err := flags.maxFeePerGasCap(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch latest block: %v", err)
+	}
+
+	if header.BaseFee == nil {
+		gasPrice := flags.MaxFeePerGas
+		if gasPrice == nil {
+			gasPrice, err = client.SuggestGasPrice(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch gas price: %v", err)
+			}
+		}
+
+		fees := &Fees{Strategy: strategy, GasPrice: gasPrice, MaxFeePerGasCap: maxFeePerGasCap}
+		if err := CheckFeeCap(fees, maxFeePerGasCap, flags.MaxFeePerGas != nil); err != nil {
+			return nil, err
+		}
+		return fees, nil
+	}
+
+	tip, baseFee, err := suggestPriorityFee(ctx, client, strategy, header.BaseFee)
+	if err != nil {
+		return nil, err
+	}
+	if flags.MaxPriorityFeePerGas != nil {
+		tip = flags.MaxPriorityFeePerGas
+	}
+
+	maxFee := flags.MaxFeePerGas
+	if maxFee == nil {
+		maxFee = new(big.Int).Mul(baseFee, big.NewInt(BaseFeeMultiplier))
+		maxFee.Add(maxFee, tip)
+	}
+	if maxFee.Cmp(tip) < 0 {
+		return nil, fmt.Errorf("max fee per gas %s is lower than the priority fee %s", maxFee.String(), tip.String())
+	}
+
+	fees := &Fees{Strategy: strategy, BaseFee: baseFee, MaxFeePerGas: maxFee, MaxPriorityFeePerGas: tip, MaxFeePerGasCap: maxFeePerGasCap}
+	if err := CheckFeeCap(fees, maxFeePerGasCap, flags.MaxFeePerGas != nil); err != nil {
+		return nil, err
+	}
+	return fees, nil
+}
+
+// PrintSummary prints the transaction and the fees it was priced with before it is sent
+func PrintSummary(transaction *types.Transaction, fees *Fees) {
+	fmt.Println("Transaction summary:")
+	fmt.Println("  To:", transaction.To().Hex())
+	fmt.Println("  Value:", transaction.Value().String(), "wei")
+	fmt.Println("  Nonce:", transaction.Nonce())
+	fmt.Println("  Gas limit:", transaction.Gas())
+	if fees.IsLegacy() {
+		fmt.Println("  Gas price:", FormatGwei(fees.GasPrice), "gwei (legacy transaction)")
+	} else {
+		if fees.Strategy != "" {
+			fmt.Println("  Fee strategy:", fees.Strategy)
+		}
+		if fees.BaseFee != nil {
+			fmt.Println("  Base fee:", FormatGwei(fees.BaseFee), "gwei")
+		}
+		fmt.Println("  Max priority fee per gas:", FormatGwei(fees.MaxPriorityFeePerGas), "gwei")
+		fmt.Println("  Max fee per gas:", FormatGwei(fees.MaxFeePerGas), "gwei")
+	}
+	if fees.MaxFeePerGasCap != nil {
+		fmt.Println("  Max fee per gas cap:", FormatGwei(fees.MaxFeePerGasCap), "gwei")
+	}
+	fmt.Println("  Max cost:", transaction.Cost().String(), "wei")
+}
+
+// NewTransaction simulates the transaction with Simulate, then builds it unsigned from the account, with
+// the pending nonce of the account, the fees of SuggestFees and an estimated gas limit unless
+// --gas-limit is set
+func NewTransaction(ctx context.Context, client *ethclient.Client, from common.Address, to common.Address, value *big.Int, data []byte, flags *Flags) (*types.Transaction, *Fees, error) {
+	if flags == nil {
+		flags = &Flags{}
+	}
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch chain ID: %v", err)
+	}
+
+	nonce, err := client.PendingNonceAt(ctx, from)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch nonce of %s: %v", from.Hex(), err)
+	}
+
+	fees, err := SuggestFees(ctx, client, flags)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := Simulate(ctx, client, from, to, value, data, flags.GasLimit); err != nil {
+		return nil, nil, err
+	}
+
+	gasLimit := flags.GasLimit
+	if gasLimit == 0 {
+		gasLimit, err = client.EstimateGas(ctx, ethereum.CallMsg{From: from, To: &to, Value: value, Data: data})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to estimate gas: %v", err)
+		}
+	}
+
+	if fees.IsLegacy() {
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    nonce,
+			GasPrice: fees.GasPrice,
+			Gas:      gasLimit,
+			To:       &to,
+			Value:    value,
+			Data:     data,
+		}), fees, nil
+	}
+
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		GasTipCap: fees.MaxPriorityFeePerGas,
+		GasFeeCap: fees.MaxFeePerGas,
+		Gas:       gasLimit,
+		To:        &to,
+		Value:     value,
+		Data:      data,
+	}), fees, nil
+}
+
+// SignTransaction signs the transaction with the key, for the chain of the client
+func SignTransaction(ctx context.Context, client *ethclient.Client, key *keystore.Key, transaction *types.Transaction) (*types.Transaction, error) {
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch chain ID: %v", err)
+	}
+
+	return types.SignTx(transaction, types.LatestSignerForChainID(chainID), key.PrivateKey)
+}
+
+// Send builds, signs and sends a transaction from the key, printing its summary first. Unless
+// --confirmations is 0, it waits for the transaction to be confirmed and fails with the decoded
+// revert reason if it reverted. With --unsigned-out, the unsigned transaction is written to the file
+// instead and returned.
+func Send(ctx context.Context, client *ethclient.Client, key *keystore.Key, to common.Address, value *big.Int, data []byte, flags *Flags) (*types.Transaction, error) {
+	transaction, _, err := SendWithReceipt(ctx, client, key, to, value, data, flags)
+	return transaction, err
+}
+
+// SendWithReceipt is Send, also returning the checked receipt of the transaction. The receipt is nil
+// if --confirmations is 0 or with --unsigned-out.
+func SendWithReceipt(ctx context.Context, client *ethclient.Client, key *keystore.Key, to common.Address, value *big.Int, data []byte, flags *Flags) (*types.Transaction, *types.Receipt, error) {
+	transaction, fees, err := NewTransaction(ctx, client, key.Address, to, value, data, flags)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if flags.Unsigned() {
+		return transaction, nil, WriteUnsigned(ctx, client, key.Address, transaction, fees, flags)
+	}
+
+	return signAndSend(ctx, client, key, transaction, fees, flags)
+}
+
+// WriteUnsigned prints the summary of the unsigned transaction and writes it to --unsigned-out
+func WriteUnsigned(ctx context.Context, client *ethclient.Client, from common.Address, transaction *types.Transaction, fees *Fees, flags *Flags) error {
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch chain ID: %v", err)
+	}
+
+	PrintSummary(transaction, fees)
+	if err := WriteUnsignedTransaction(flags.UnsignedOut, NewUnsignedTransaction(from, chainID, transaction)); err != nil {
+		return err
+	}
+	fmt.Println("Unsigned transaction written to", flags.UnsignedOut)
+	fmt.Println("Sign it with bifrost tx sign, then send it with bifrost tx broadcast")
+	return nil
+}
+
+// SignAndSend prints the summary of the unsigned transaction, signs it with the key and sends it.
+// Unless --confirmations is 0, it waits for the transaction to be confirmed and checks its receipt.
+func SignAndSend(ctx context.Context, client *ethclient.Client, key *keystore.Key, transaction *types.Transaction, fees *Fees, flags *Flags) (*types.Transaction, error) {
+	signedTransaction, _, err := signAndSend(ctx, client, key, transaction, fees, flags)
+	return signedTransaction, err
+}
+
+func signAndSend(ctx context.Context, client *ethclient.Client, key *keystore.Key, transaction *types.Transaction, fees *Fees, flags *Flags) (*types.Transaction, *types.Receipt, error) {
+	if flags == nil {
+		flags = &Flags{}
+	}
+	PrintSummary(transaction, fees)
+
+	signedTransaction, err := SignTransaction(ctx, client, key, transaction)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	receipt, err := Broadcast(ctx, client, signedTransaction, flags.Confirmations)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return signedTransaction, receipt, nil
+}
+
+// Broadcast sends the signed transaction. Unless confirmations is 0, it waits for the transaction to
+// be confirmed and returns its checked receipt.
+func Broadcast(ctx context.Context, client *ethclient.Client, signedTransaction *types.Transaction, confirmations uint64) (*types.Receipt, error) {
+	if err := client.SendTransaction(ctx, signedTransaction); err != nil {
+		return nil, err
+	}
+
+	if confirmations == 0 {
+		return nil, nil
+	}
+
+	fmt.Println("Waiting for", confirmations, "confirmation(s) of", signedTransaction.Hash().Hex())
+	receipt, err := WaitForReceipt(ctx, client, signedTransaction, confirmations)
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckReceipt(ctx, client, signedTransaction, receipt); err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+// WaitForReceipt waits for the transaction to be mined and for the block that includes it to have
+// the given number of confirmations, counting that block. If the transaction is reorged out while
+// waiting, it waits for it to be mined again.
+func WaitForReceipt(ctx context.Context, client *ethclient.Client, transaction *types.Transaction, confirmations uint64) (*types.Receipt, error) {
+	for {
+		receipt, err := bind.WaitMined(ctx, client, transaction)
+		if err != nil {
+			return nil, fmt.Errorf("failed to wait for transaction %s: %v", transaction.Hash().Hex(), err)
+		}
+		if confirmations <= 1 {
+			return receipt, nil
+		}
+
+		target := receipt.BlockNumber.Uint64() + confirmations - 1
+		for {
+			head, headErr := client.BlockNumber(ctx)
+			if headErr != nil {
+				return nil, fmt.Errorf("failed to fetch block number: %v", headErr)
+			}
+			if head >= target {
+				break
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(ConfirmationPollInterval):
+			}
+		}
+
+		confirmed, err := client.TransactionReceipt(ctx, transaction.Hash())
+		if err == nil && confirmed.BlockHash == receipt.BlockHash {
+			return confirmed, nil
+		}
+		fmt.Println("Transaction", transaction.Hash().Hex(), "was reorged out of block", receipt.BlockNumber.String(), "waiting for it to be mined again")
+	}
+}
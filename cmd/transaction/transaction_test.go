@@ -0,0 +1,267 @@
+package transaction
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// testFeeHistory has a base fee of 11 gwei for the next block, and priority fees of 1, 3 and 2 gwei
+// at the requested percentile in the non-empty blocks
+var testFeeHistory = map[string]interface{}{
+	"oldestBlock":   "0x61",
+	"baseFeePerGas": []string{"0x218711a00", "0x2540be400", "0x28fa6ae00", "0x28fa6ae00"},
+	"gasUsedRatio":  []float64{0.5, 0.9, 0, 0.4},
+	"reward":        [][]string{{"0x3b9aca00"}, {"0xb2d05e00"}, {"0x174876e800"}, {"0x77359400"}},
+}
+
+// newTestClient serves chain 1 with the latest block with the base fee, the gas price and priority fee
+// suggestions of the node, and testFeeHistory unless feeHistory is false
+func newTestClient(t *testing.T, baseFee *big.Int, gasPrice int64, tip int64, feeHistory bool) *ethclient.Client {
+	header := &types.Header{Number: big.NewInt(100), Difficulty: big.NewInt(0), BaseFee: baseFee}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Fatal(err)
+		}
+
+		var result interface{}
+		switch request.Method {
+		case "eth_chainId":
+			result = (*hexutil.Big)(big.NewInt(1))
+		case "eth_getBlockByNumber":
+			result = header
+		case "eth_gasPrice":
+			result = (*hexutil.Big)(big.NewInt(gasPrice))
+		case "eth_maxPriorityFeePerGas":
+			result = (*hexutil.Big)(big.NewInt(tip))
+		case "eth_feeHistory":
+			if !feeHistory {
+				json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": request.ID, "error": map[string]interface{}{"code": -32601, "message": "method not found"}})
+				return
+			}
+			result = testFeeHistory
+		default:
+			t.Errorf("unexpected method %s", request.Method)
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": request.ID, "result": result})
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := ethclient.Dial(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestSuggestFees(t *testing.T) {
+	client := newTestClient(t, big.NewInt(10_000_000_000), 12_000_000_000, 1_000_000_000, true)
+
+	fees, err := SuggestFees(context.Background(), client, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fees.IsLegacy() {
+		t.Fatal("expected EIP-1559 fees")
+	}
+	// The median priority fee of the non-empty blocks is 2 gwei: 11 gwei * 2 + 2 gwei
+	if fees.BaseFee.Int64() != 11_000_000_000 || fees.MaxFeePerGas.Int64() != 24_000_000_000 || fees.MaxPriorityFeePerGas.Int64() != 2_000_000_000 {
+		t.Errorf("unexpected fees %+v", fees)
+	}
+
+	flags := &Flags{MaxFeePerGasRaw: "30000000000", MaxPriorityFeePerGasRaw: "2000000000"}
+	if err := flags.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	fees, err = SuggestFees(context.Background(), client, flags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fees.MaxFeePerGas.Int64() != 30_000_000_000 || fees.MaxPriorityFeePerGas.Int64() != 2_000_000_000 {
+		t.Errorf("expected the fees of the flags, got %+v", fees)
+	}
+
+	flags = &Flags{MaxFeePerGasRaw: "500000000"}
+	if err := flags.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := SuggestFees(context.Background(), client, flags); err == nil {
+		t.Error("expected an error on a max fee below the suggested priority fee")
+	}
+}
+
+func TestSuggestFeesLegacy(t *testing.T) {
+	client := newTestClient(t, nil, 12_000_000_000, 1_000_000_000, false)
+
+	fees, err := SuggestFees(context.Background(), client, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !fees.IsLegacy() || fees.GasPrice.Int64() != 12_000_000_000 {
+		t.Errorf("expected a legacy gas price of 12 gwei, got %+v", fees)
+	}
+
+	flags := &Flags{MaxFeePerGasRaw: "15000000000"}
+	if err := flags.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	fees, err = SuggestFees(context.Background(), client, flags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fees.GasPrice.Int64() != 15_000_000_000 {
+		t.Errorf("expected --max-fee-per-gas as gas price, got %s", fees.GasPrice.String())
+	}
+
+	flags = &Flags{MaxFeePerGasCapRaw: []string{"1=10"}}
+	if err := flags.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := SuggestFees(context.Background(), client, flags); err == nil {
+		t.Error("expected an error on a gas price above the cap")
+	}
+}
+
+func TestSuggestFeesWithoutFeeHistory(t *testing.T) {
+	client := newTestClient(t, big.NewInt(10_000_000_000), 12_000_000_000, 1_000_000_000, false)
+
+	fees, err := SuggestFees(context.Background(), client, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Falls back to the latest base fee and the suggested tip: 10 gwei * 2 + 1 gwei
+	if fees.MaxFeePerGas.Int64() != 21_000_000_000 || fees.MaxPriorityFeePerGas.Int64() != 1_000_000_000 {
+		t.Errorf("unexpected fees %+v", fees)
+	}
+}
+
+func TestSuggestFeesCap(t *testing.T) {
+	client := newTestClient(t, big.NewInt(10_000_000_000), 12_000_000_000, 1_000_000_000, true)
+
+	testCases := []struct {
+		cap      string
+		maxFee   string
+		expected int64
+		fails    bool
+	}{
+		// Below the cap, the fees are unchanged
+		{cap: "1=30", expected: 24_000_000_000},
+		// Above the cap but includable now, the max fee per gas is lowered to the cap
+		{cap: "1=20", expected: 20_000_000_000},
+		// The cap of another chain does not apply
+		{cap: "8453=0.5", expected: 24_000_000_000},
+		// Base fee and priority fee are above the cap, the gas spike aborts the transaction
+		{cap: "1=12", fails: true},
+		// A --max-fee-per-gas above the cap of the chain is rejected
+		{cap: "1=20", maxFee: "25000000000", fails: true},
+	}
+	for _, testCase := range testCases {
+		flags := &Flags{MaxFeePerGasCapRaw: []string{testCase.cap}, MaxFeePerGasRaw: testCase.maxFee}
+		if err := flags.Parse(); err != nil {
+			t.Fatal(err)
+		}
+
+		fees, err := SuggestFees(context.Background(), client, flags)
+		if testCase.fails {
+			if err == nil {
+				t.Errorf("cap %s: expected an error, got %+v", testCase.cap, fees)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("cap %s: unexpected error: %v", testCase.cap, err)
+		}
+		if fees.MaxFeePerGas.Int64() != testCase.expected {
+			t.Errorf("cap %s: expected max fee per gas %d, got %s", testCase.cap, testCase.expected, fees.MaxFeePerGas.String())
+		}
+	}
+}
+
+func TestFeeStrategies(t *testing.T) {
+	for _, strategy := range FeeStrategies {
+		if parsed, err := ParseFeeStrategy(string(strategy)); err != nil || parsed != strategy {
+			t.Errorf("failed to parse %s: %v", strategy, err)
+		}
+	}
+	if _, err := ParseFeeStrategy("instant"); err == nil {
+		t.Error("expected an error on an unknown strategy")
+	}
+
+	flags := &Flags{}
+	if err := flags.Parse(); err != nil || flags.FeeStrategy != FeeStrategyStandard {
+		t.Errorf("expected the standard strategy by default, got %q: %v", flags.FeeStrategy, err)
+	}
+}
+
+func TestFormatGwei(t *testing.T) {
+	testCases := map[int64]string{
+		0:              "0",
+		1:              "0.000000001",
+		1_500_000_000:  "1.5",
+		24_000_000_000: "24",
+	}
+	for wei, expected := range testCases {
+		if formatted := FormatGwei(big.NewInt(wei)); formatted != expected {
+			t.Errorf("FormatGwei(%d): expected %s, got %s", wei, expected, formatted)
+		}
+	}
+}
+
+func TestParseFeeCaps(t *testing.T) {
+	flags := &Flags{MaxFeePerGasCapRaw: []string{"1=100", "8453=0.05", "42161 = 1.000000001"}}
+	if err := flags.Parse(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[int64]int64{
+		1:     100_000_000_000,
+		8453:  50_000_000,
+		42161: 1_000_000_001,
+	}
+	for chainID, wei := range expected {
+		if maxFee := flags.MaxFeePerGasCap(big.NewInt(chainID)); maxFee == nil || maxFee.Int64() != wei {
+			t.Errorf("chain %d: expected a cap of %d wei, got %v", chainID, wei, maxFee)
+		}
+	}
+	if maxFee := flags.MaxFeePerGasCap(big.NewInt(10)); maxFee != nil {
+		t.Errorf("expected no cap on chain 10, got %s", maxFee.String())
+	}
+
+	for _, invalid := range []string{"1=", "1=1.0000000001", "1=-1", "1=1e9", "=1", "base=1"} {
+		if _, err := ParseFeeCaps([]string{invalid}); err == nil {
+			t.Errorf("expected an error for %q", invalid)
+		}
+	}
+}
+
+func TestParseFlags(t *testing.T) {
+	invalid := []*Flags{
+		{MaxFeePerGasRaw: "1.5"},
+		{MaxFeePerGasRaw: "0"},
+		{MaxPriorityFeePerGasRaw: "-1"},
+		{MaxFeePerGasRaw: "1", MaxPriorityFeePerGasRaw: "2"},
+		{MaxFeePerGasCapRaw: []string{"abc"}},
+		{MaxFeePerGasCapRaw: []string{"30"}},
+		{MaxFeePerGasCapRaw: []string{"1=0"}},
+		{MaxFeePerGasCapRaw: []string{"1=30", "1=40"}},
+		{FeeStrategyRaw: "instant"},
+	}
+	for _, flags := range invalid {
+		if err := flags.Parse(); err == nil {
+			t.Errorf("expected an error for %+v", flags)
+		}
+	}
+}
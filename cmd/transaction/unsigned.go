@@ -0,0 +1,163 @@
+package transaction
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// UnsignedTransaction is the fully built transaction written by --unsigned-out, to be signed on
+// another machine with tx sign. GasPrice is only set for legacy transactions, on chains without
+// EIP-1559, in place of the fees per gas.
+type UnsignedTransaction struct {
+	From                 common.Address `json:"from"`
+	ChainID              *hexutil.Big   `json:"chainId"`
+	Nonce                hexutil.Uint64 `json:"nonce"`
+	MaxPriorityFeePerGas *hexutil.Big   `json:"maxPriorityFeePerGas,omitempty"`
+	MaxFeePerGas         *hexutil.Big   `json:"maxFeePerGas,omitempty"`
+	GasPrice             *hexutil.Big   `json:"gasPrice,omitempty"`
+	Gas                  hexutil.Uint64 `json:"gas"`
+	To                   common.Address `json:"to"`
+	Value                *hexutil.Big   `json:"value"`
+	Data                 hexutil.Bytes  `json:"data"`
+}
+
+// NewUnsignedTransaction returns the fields of the unsigned transaction from the account
+func NewUnsignedTransaction(from common.Address, chainID *big.Int, transaction *types.Transaction) *UnsignedTransaction {
+	unsigned := &UnsignedTransaction{
+		From:    from,
+		ChainID: (*hexutil.Big)(chainID),
+		Nonce:   hexutil.Uint64(transaction.Nonce()),
+		Gas:     hexutil.Uint64(transaction.Gas()),
+		To:      *transaction.To(),
+		Value:   (*hexutil.Big)(transaction.Value()),
+		Data:    transaction.Data(),
+	}
+	if transaction.Type() == types.LegacyTxType {
+		unsigned.GasPrice = (*hexutil.Big)(transaction.GasPrice())
+	} else {
+		unsigned.MaxPriorityFeePerGas = (*hexutil.Big)(transaction.GasTipCap())
+		unsigned.MaxFeePerGas = (*hexutil.Big)(transaction.GasFeeCap())
+	}
+	return unsigned
+}
+
+// Transaction returns the transaction to sign, a DynamicFeeTx unless the gas price is set
+func (u *UnsignedTransaction) Transaction() (*types.Transaction, error) {
+	if u.ChainID == nil || u.Value == nil {
+		return nil, errors.New("unsigned transaction is missing chainId or value")
+	}
+
+	if u.GasPrice != nil {
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    uint64(u.Nonce),
+			GasPrice: u.GasPrice.ToInt(),
+			Gas:      uint64(u.Gas),
+			To:       &u.To,
+			Value:    u.Value.ToInt(),
+			Data:     u.Data,
+		}), nil
+	}
+
+	if u.MaxPriorityFeePerGas == nil || u.MaxFeePerGas == nil {
+		return nil, errors.New("unsigned transaction is missing maxPriorityFeePerGas or maxFeePerGas")
+	}
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   u.ChainID.ToInt(),
+		Nonce:     uint64(u.Nonce),
+		GasTipCap: u.MaxPriorityFeePerGas.ToInt(),
+		GasFeeCap: u.MaxFeePerGas.ToInt(),
+		Gas:       uint64(u.Gas),
+		To:        &u.To,
+		Value:     u.Value.ToInt(),
+		Data:      u.Data,
+	}), nil
+}
+
+// Fees returns the fees the unsigned transaction was priced with, for its summary
+func (u *UnsignedTransaction) Fees() *Fees {
+	if u.GasPrice != nil {
+		return &Fees{GasPrice: u.GasPrice.ToInt()}
+	}
+	return &Fees{MaxFeePerGas: u.MaxFeePerGas.ToInt(), MaxPriorityFeePerGas: u.MaxPriorityFeePerGas.ToInt()}
+}
+
+// WriteUnsignedTransaction writes the unsigned transaction as JSON. It never overwrites an existing
+// file, so that a transaction built for another nonce is not signed by mistake.
+func WriteUnsignedTransaction(path string, unsigned *UnsignedTransaction) error {
+	encoded, err := json.MarshalIndent(unsigned, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %v", path, err)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(append(encoded, '\n')); err != nil {
+		return fmt.Errorf("failed to write %s: %v", path, err)
+	}
+	return nil
+}
+
+// ReadUnsignedTransaction reads an unsigned transaction written by WriteUnsignedTransaction
+func ReadUnsignedTransaction(path string) (*UnsignedTransaction, error) {
+	encoded, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	unsigned := &UnsignedTransaction{}
+	if err := json.Unmarshal(encoded, unsigned); err != nil {
+		return nil, fmt.Errorf("failed to parse unsigned transaction %s: %v", path, err)
+	}
+	return unsigned, nil
+}
+
+// WriteSignedTransaction writes the raw RLP encoding of the signed transaction in hex, without
+// overwriting an existing file
+func WriteSignedTransaction(path string, signedTransaction *types.Transaction) error {
+	raw, err := signedTransaction.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %v", path, err)
+	}
+	defer file.Close()
+
+	if _, err := file.WriteString(hexutil.Encode(raw) + "\n"); err != nil {
+		return fmt.Errorf("failed to write %s: %v", path, err)
+	}
+	return nil
+}
+
+// ReadSignedTransaction reads a signed transaction written by tx sign: its raw RLP encoding, in hex
+func ReadSignedTransaction(path string) (*types.Transaction, error) {
+	encoded, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := hexutil.Decode(strings.TrimSpace(string(encoded)))
+	if err != nil {
+		return nil, fmt.Errorf("%s does not hold a hex encoded transaction: %v", path, err)
+	}
+
+	transaction := new(types.Transaction)
+	if err := transaction.UnmarshalBinary(raw); err != nil {
+		return nil, fmt.Errorf("failed to decode signed transaction %s: %v", path, err)
+	}
+	return transaction, nil
+}
@@ -0,0 +1,111 @@
+package transaction
+
+import (
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestUnsignedTransactionRoundTrip(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	to := common.HexToAddress("0x2Fc99fd16D8D3F6F66d164aA84E244c567E58A3d")
+	chainID := big.NewInt(8453)
+
+	built := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     7,
+		GasTipCap: big.NewInt(1_000_000_000),
+		GasFeeCap: big.NewInt(30_000_000_000),
+		Gas:       100_000,
+		To:        &to,
+		Value:     big.NewInt(5),
+		Data:      []byte{0x01, 0x02},
+	})
+
+	unsignedPath := filepath.Join(t.TempDir(), "tx.json")
+	if err := WriteUnsignedTransaction(unsignedPath, NewUnsignedTransaction(from, chainID, built)); err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteUnsignedTransaction(unsignedPath, NewUnsignedTransaction(from, chainID, built)); err == nil {
+		t.Error("expected an error on overwriting an unsigned transaction")
+	}
+
+	unsigned, err := ReadUnsignedTransaction(unsignedPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if unsigned.From != from {
+		t.Errorf("expected from %s, got %s", from.Hex(), unsigned.From.Hex())
+	}
+	read, err := unsigned.Transaction()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if read.Hash() != built.Hash() {
+		t.Errorf("unsigned transaction changed through its file: %+v", unsigned)
+	}
+
+	signed, err := types.SignTx(read, types.LatestSignerForChainID(unsigned.ChainID.ToInt()), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signedPath := filepath.Join(t.TempDir(), "tx.signed")
+	if err := WriteSignedTransaction(signedPath, signed); err != nil {
+		t.Fatal(err)
+	}
+	broadcast, err := ReadSignedTransaction(signedPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if broadcast.Hash() != signed.Hash() {
+		t.Errorf("expected signed transaction %s, got %s", signed.Hash().Hex(), broadcast.Hash().Hex())
+	}
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), broadcast)
+	if err != nil || sender != from {
+		t.Errorf("expected sender %s, got %s (%v)", from.Hex(), sender.Hex(), err)
+	}
+}
+
+func TestUnsignedTransactionLegacy(t *testing.T) {
+	to := common.HexToAddress("0x2Fc99fd16D8D3F6F66d164aA84E244c567E58A3d")
+	built := types.NewTx(&types.LegacyTx{Nonce: 7, GasPrice: big.NewInt(20_000_000_000), Gas: 21_000, To: &to, Value: big.NewInt(5)})
+
+	unsigned := NewUnsignedTransaction(common.Address{}, big.NewInt(1), built)
+	if unsigned.GasPrice == nil || unsigned.MaxFeePerGas != nil {
+		t.Errorf("expected a gas price and no fees per gas, got %+v", unsigned)
+	}
+	read, err := unsigned.Transaction()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if read.Type() != types.LegacyTxType || read.Hash() != built.Hash() {
+		t.Errorf("expected the legacy transaction %s, got %s", built.Hash().Hex(), read.Hash().Hex())
+	}
+}
+
+func TestParseUnsignedOutFlags(t *testing.T) {
+	flags := &Flags{UnsignedOut: "tx.json"}
+	if err := flags.Parse(); err == nil {
+		t.Error("expected an error on --unsigned-out without --from")
+	}
+
+	flags = &Flags{UnsignedOut: "tx.json", FromRaw: "0x3154Cf16ccdb4C6d922629664174b904d80F2C35"}
+	if err := flags.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	key, err := flags.Key("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !flags.Unsigned() || key.Address != flags.From || key.PrivateKey != nil {
+		t.Errorf("expected a key holding only the --from address, got %s", key.Address.Hex())
+	}
+}